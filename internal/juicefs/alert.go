@@ -0,0 +1,101 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Metrics alert rules can watch.
+const (
+	AlertMetricUsagePercent      = "usage_percent"
+	AlertMetricInodeUsagePercent = "inode_usage_percent"
+	AlertMetricTrashBytes        = "trash_bytes"
+	AlertMetricClientDisconnects = "client_disconnects"
+)
+
+// AlertMetrics lists the valid values of AlertRule.Metric.
+var AlertMetrics = []string{
+	AlertMetricUsagePercent,
+	AlertMetricInodeUsagePercent,
+	AlertMetricTrashBytes,
+	AlertMetricClientDisconnects,
+}
+
+// AlertRule fires when Metric of the volume stays above Threshold for
+// Duration seconds, notifying every channel in Channels.
+type AlertRule struct {
+	Id        int64   `json:"id"`
+	Volume    int64   `json:"volume"`
+	Name      string  `json:"name"`
+	Metric    string  `json:"metric"`
+	Threshold float64 `json:"threshold"`
+	Duration  int64   `json:"duration"`
+	Channels  []int64 `json:"channels"`
+	Enabled   bool    `json:"enabled"`
+}
+
+type AlertRuleRequest struct {
+	Name      string  `json:"name"`
+	Metric    string  `json:"metric"`
+	Threshold float64 `json:"threshold"`
+	Duration  int64   `json:"duration"`
+	Channels  []int64 `json:"channels"`
+	Enabled   bool    `json:"enabled"`
+}
+
+func (c *Client) CreateAlertRule(volumeID int64, req AlertRuleRequest) (*AlertRule, error) {
+	u := fmt.Sprintf("%s/volumes/%d/alert_rules", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create alert rule, status code %d, error %s", statusCode, body)
+	}
+	successRet := &AlertRule{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetAlertRule(volumeID int64, ruleID int64) (*AlertRule, error) {
+	u := fmt.Sprintf("%s/volumes/%d/alert_rules/%d", c.Endpoint, volumeID, ruleID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("alert rule %d %w", ruleID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get alert rule %d, statusCode: %d, error: %s", ruleID, statusCode, string(body))
+	}
+	successRet := &AlertRule{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateAlertRule(volumeID int64, ruleID int64, req AlertRuleRequest) (*AlertRule, error) {
+	u := fmt.Sprintf("%s/volumes/%d/alert_rules/%d", c.Endpoint, volumeID, ruleID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update alert rule %d, status code %d, error %s", ruleID, statusCode, body)
+	}
+	successRet := &AlertRule{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteAlertRule(volumeID int64, ruleID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/alert_rules/%d", c.Endpoint, volumeID, ruleID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete alert rule, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
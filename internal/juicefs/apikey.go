@@ -0,0 +1,74 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type APIKey struct {
+	Id          int64      `json:"id"`
+	AccessKey   string     `json:"access_key"`
+	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	Volumes     []int64    `json:"volumes"`
+	Expires     *time.Time `json:"expires"`
+	Created     time.Time  `json:"created"`
+}
+
+type CreateAPIKeyRequest struct {
+	Description string     `json:"description,omitempty"`
+	Scopes      []string   `json:"scopes,omitempty"`
+	Volumes     []int64    `json:"volumes,omitempty"`
+	Expires     *time.Time `json:"expires,omitempty"`
+}
+
+// CreateAPIKeyResponse carries the secret key of a new API key. It is only
+// returned on creation.
+type CreateAPIKeyResponse struct {
+	APIKey
+	SecretKey string `json:"secret_key"`
+}
+
+func (c *Client) CreateAPIKey(req CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	u := fmt.Sprintf("%s/api_keys", c.Endpoint)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create api key, status code %d, error %s", statusCode, body)
+	}
+	successRet := &CreateAPIKeyResponse{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetAPIKey(keyID int64) (*APIKey, error) {
+	u := fmt.Sprintf("%s/api_keys/%d", c.Endpoint, keyID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("api key %d %w", keyID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get api key %d, statusCode: %d, error: %s", keyID, statusCode, string(body))
+	}
+	successRet := &APIKey{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteAPIKey(keyID int64) error {
+	u := fmt.Sprintf("%s/api_keys/%d", c.Endpoint, keyID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete api key, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAPIKey(t *testing.T) {
+	var created map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api_keys":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":1,"access_key":"ak","secret_key":"sk","scopes":["volumes:read"],"volumes":null,"expires":"2030-01-02T03:04:05Z","created":"2024-01-01T00:00:00Z"}`)
+		case r.Method == "GET" && r.URL.Path == "/api_keys/1":
+			fmt.Fprint(w, `{"id":1,"access_key":"ak","scopes":["volumes:read"],"volumes":null,"expires":null,"created":"2024-01-01T00:00:00Z"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	key, err := c.CreateAPIKey(CreateAPIKeyRequest{Scopes: []string{"volumes:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.SecretKey != "sk" || key.Expires == nil || !key.Expires.Equal(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("CreateAPIKey() = %+v", key)
+	}
+	for _, field := range []string{"description", "volumes", "expires"} {
+		if _, ok := created[field]; ok {
+			t.Errorf("CreateAPIKey() sent %s = %v, want it omitted", field, created[field])
+		}
+	}
+
+	got, err := c.GetAPIKey(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Expires != nil || got.Volumes != nil || len(got.Scopes) != 1 {
+		t.Errorf("GetAPIKey() = %+v", got)
+	}
+
+	if _, err := c.GetAPIKey(2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetAPIKey() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,69 @@
+package juicefs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuditLogFilterValues(t *testing.T) {
+	if got := (AuditLogFilter{}).values().Encode(); got != "" {
+		t.Errorf("empty filter encoded as %q", got)
+	}
+	f := AuditLogFilter{
+		Start:  time.Unix(1700000000, 0),
+		End:    time.Unix(1700086400, 0),
+		Volume: 42,
+		Actor:  "ops@example.com",
+		Action: "export.update",
+	}
+	want := "action=export.update&actor=ops%40example.com&end=1700086400&start=1700000000&volume=42"
+	if got := f.values().Encode(); got != want {
+		t.Errorf("values() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteAuditLogs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/audit_logs" || r.URL.Query().Get("volume") != "42" {
+			http.Error(w, "unexpected request "+r.URL.String(), http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprintf(w, `{"count":3,"next":"%s/audit_logs?page=2","results":[{"id":1,"action":"export.create"},{"id":2,"action":"export.update"}]}`, "http://"+r.Host)
+		case "2":
+			fmt.Fprint(w, `{"count":3,"next":null,"results":[{"id":3,"action":"quota.update"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "0123456789abcdefghij"}
+	var buf bytes.Buffer
+	n, err := c.WriteAuditLogs(&buf, AuditLogFilter{Volume: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("wrote %d events, want 3", n)
+	}
+	var ids []int64
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var event AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("line %q is not an event: %s", scanner.Text(), err)
+		}
+		ids = append(ids, event.Id)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Errorf("got events %v, want [1 2 3]", ids)
+	}
+}
@@ -0,0 +1,58 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// BillingDateLayout is the format of the dates of a billing period.
+const BillingDateLayout = "2006-01-02"
+
+// BillingItem is the usage and cost of a volume or a region over a billing
+// period. Fees are in the currency of the report.
+type BillingItem struct {
+	MetadataFee  float64 `json:"metadata_fee"`
+	StorageBytes int64   `json:"storage_bytes"`
+	Requests     int64   `json:"requests"`
+	Total        float64 `json:"total"`
+}
+
+type VolumeBilling struct {
+	BillingItem
+	Volume int64  `json:"volume"`
+	Name   string `json:"name"`
+	Region int64  `json:"region"`
+}
+
+type RegionBilling struct {
+	BillingItem
+	Region int64 `json:"region"`
+}
+
+type BillingReport struct {
+	Currency string          `json:"currency"`
+	Total    float64         `json:"total"`
+	Volumes  []VolumeBilling `json:"volumes"`
+	Regions  []RegionBilling `json:"regions"`
+}
+
+// GetBilling returns the usage and fees between start and end, both
+// inclusive. Only the date part of start and end is used.
+func (c *Client) GetBilling(start time.Time, end time.Time) (*BillingReport, error) {
+	u := fmt.Sprintf("%s/billing", c.Endpoint)
+	params := url.Values{}
+	params.Set("start", start.Format(BillingDateLayout))
+	params.Set("end", end.Format(BillingDateLayout))
+	statusCode, body, err := c.request("GET", u, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get billing, statusCode: %d, error: %s", statusCode, string(body))
+	}
+	successRet := &BillingReport{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
@@ -0,0 +1,35 @@
+package juicefs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetBilling(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/billing" || q.Get("start") != "2024-01-01" || q.Get("end") != "2024-01-31" {
+			http.Error(w, "unexpected request "+r.URL.String(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"currency":"USD","total":12.5,"volumes":[{"volume":42,"name":"myjfs","region":1,"metadata_fee":2.5,"storage_bytes":1024,"requests":100,"total":12.5}],"regions":[{"region":1,"total":12.5}]}`)
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	start := time.Date(2024, 1, 1, 15, 4, 5, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	report, err := c.GetBilling(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Currency != "USD" || report.Total != 12.5 || len(report.Volumes) != 1 || len(report.Regions) != 1 {
+		t.Fatalf("GetBilling() = %+v", report)
+	}
+	if v := report.Volumes[0]; v.Volume != 42 || v.Name != "myjfs" || v.MetadataFee != 2.5 || v.StorageBytes != 1024 || v.Requests != 100 {
+		t.Errorf("volume billing = %+v", v)
+	}
+}
@@ -0,0 +1,86 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Status values reported for cache groups.
+const (
+	CacheGroupStatusReady  = "ready"
+	CacheGroupStatusFailed = "failed"
+)
+
+type CacheGroup struct {
+	Id          int64    `json:"id"`
+	Volume      int64    `json:"volume"`
+	Name        string   `json:"name"`
+	Hosts       []string `json:"hosts"`
+	CacheSize   int64    `json:"cache_size"`
+	WarmupPaths []string `json:"warmup_paths"`
+	Status      string   `json:"status"`
+}
+
+type CacheGroupRequest struct {
+	Name        string   `json:"name"`
+	Hosts       []string `json:"hosts"`
+	CacheSize   int64    `json:"cache_size"`
+	WarmupPaths []string `json:"warmup_paths"`
+}
+
+func (c *Client) CreateCacheGroup(volumeID int64, req CacheGroupRequest) (*CacheGroup, error) {
+	u := fmt.Sprintf("%s/volumes/%d/cache_groups", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create cache group, status code %d, error %s", statusCode, body)
+	}
+	successRet := &CacheGroup{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetCacheGroup(volumeID int64, groupID int64) (*CacheGroup, error) {
+	u := fmt.Sprintf("%s/volumes/%d/cache_groups/%d", c.Endpoint, volumeID, groupID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("cache group %d %w", groupID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get cache group %d, statusCode: %d, error: %s", groupID, statusCode, string(body))
+	}
+	successRet := &CacheGroup{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateCacheGroup(volumeID int64, groupID int64, req CacheGroupRequest) (*CacheGroup, error) {
+	u := fmt.Sprintf("%s/volumes/%d/cache_groups/%d", c.Endpoint, volumeID, groupID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update cache group %d, status code %d, error %s", groupID, statusCode, body)
+	}
+	successRet := &CacheGroup{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteCacheGroup(volumeID int64, groupID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/cache_groups/%d", c.Endpoint, volumeID, groupID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete cache group, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
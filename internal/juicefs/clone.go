@@ -0,0 +1,40 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// VolumeLineage describes where a cloned volume comes from. It is nil for
+// volumes that were not cloned.
+type VolumeLineage struct {
+	SourceVolume int64     `json:"source_volume"`
+	SourcePath   string    `json:"source_path"`
+	RootVolume   int64     `json:"root_volume"`
+	Snapshot     bool      `json:"snapshot"`
+	ClonedAt     time.Time `json:"cloned_at"`
+}
+
+type CloneVolumeRequest struct {
+	Name     string `json:"name"`
+	Path     string `json:"path,omitempty"`
+	Snapshot bool   `json:"snapshot"`
+}
+
+// CloneVolume creates a new volume sharing the metadata and data of the
+// source volume, or of a subdirectory of it, at the time of the call. Data is
+// copied on write, so cloning is fast regardless of the size of the source.
+func (c *Client) CloneVolume(sourceID int64, req CloneVolumeRequest) (*Volume, error) {
+	u := fmt.Sprintf("%s/volumes/%d/clone", c.Endpoint, sourceID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to clone volume %d, status code %d, error %s", sourceID, statusCode, body)
+	}
+	successRet := &Volume{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
@@ -0,0 +1,44 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCloneVolume(t *testing.T) {
+	var sent map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/volumes/1/clone" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":2,"name":"clone","lineage":{"source_volume":1,"source_path":"/","root_volume":1,"snapshot":false,"cloned_at":"2024-01-02T03:04:05Z"}}`)
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	volume, err := c.CloneVolume(1, CloneVolumeRequest{Name: "clone"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sent["path"]; ok || sent["name"] != "clone" || sent["snapshot"] != false {
+		t.Errorf("CloneVolume() sent %v", sent)
+	}
+	want := VolumeLineage{SourceVolume: 1, SourcePath: "/", RootVolume: 1, ClonedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	if volume.Lineage == nil || *volume.Lineage != want {
+		t.Errorf("CloneVolume() lineage = %+v, want %+v", volume.Lineage, want)
+	}
+
+	if _, err := c.CloneVolume(3, CloneVolumeRequest{Name: "clone"}); err == nil {
+		t.Error("CloneVolume() of a missing volume succeeded, want error")
+	}
+}
@@ -0,0 +1,101 @@
+package juicefs
+
+import (
+	"encoding/json"
+)
+
+// CSIDriver is the name of the JuiceFS CSI driver.
+const CSIDriver = "csi.juicefs.com"
+
+// CSIConfig holds what is needed to mount a volume through the JuiceFS CSI
+// driver.
+type CSIConfig struct {
+	Name            string
+	Token           string
+	AccessKey       string
+	SecretKey       string
+	Bucket          string
+	Envs            map[string]string
+	SecretName      string
+	SecretNamespace string
+	MountOptions    []string
+}
+
+// SecretData returns the data of the Kubernetes Secret read by the CSI driver.
+func (c CSIConfig) SecretData() (map[string]string, error) {
+	data := map[string]string{
+		"name":  c.Name,
+		"token": c.Token,
+	}
+	if c.AccessKey != "" {
+		data["access-key"] = c.AccessKey
+	}
+	if c.SecretKey != "" {
+		data["secret-key"] = c.SecretKey
+	}
+	if c.Bucket != "" {
+		data["bucket"] = c.Bucket
+	}
+	if len(c.Envs) > 0 {
+		envs, err := json.Marshal(c.Envs)
+		if err != nil {
+			return nil, err
+		}
+		data["envs"] = string(envs)
+	}
+	return data, nil
+}
+
+// StorageClass returns a StorageClass manifest provisioning volumes with the
+// secret.
+func (c CSIConfig) StorageClass(name string) map[string]interface{} {
+	manifest := map[string]interface{}{
+		"apiVersion":    "storage.k8s.io/v1",
+		"kind":          "StorageClass",
+		"metadata":      map[string]interface{}{"name": name},
+		"provisioner":   CSIDriver,
+		"reclaimPolicy": "Retain",
+		"parameters": map[string]interface{}{
+			"csi.storage.k8s.io/provisioner-secret-name":       c.SecretName,
+			"csi.storage.k8s.io/provisioner-secret-namespace":  c.SecretNamespace,
+			"csi.storage.k8s.io/node-publish-secret-name":      c.SecretName,
+			"csi.storage.k8s.io/node-publish-secret-namespace": c.SecretNamespace,
+		},
+	}
+	if len(c.MountOptions) > 0 {
+		manifest["mountOptions"] = c.MountOptions
+	}
+	return manifest
+}
+
+// PersistentVolume returns a statically provisioned PersistentVolume manifest
+// exposing the whole volume.
+func (c CSIConfig) PersistentVolume(name string, capacity string) map[string]interface{} {
+	spec := map[string]interface{}{
+		"capacity":                      map[string]interface{}{"storage": capacity},
+		"volumeMode":                    "Filesystem",
+		"accessModes":                   []string{"ReadWriteMany"},
+		"persistentVolumeReclaimPolicy": "Retain",
+		"csi": map[string]interface{}{
+			"driver":       CSIDriver,
+			"volumeHandle": name,
+			"fsType":       "juicefs",
+			"nodePublishSecretRef": map[string]interface{}{
+				"name":      c.SecretName,
+				"namespace": c.SecretNamespace,
+			},
+		},
+	}
+	if len(c.MountOptions) > 0 {
+		spec["mountOptions"] = c.MountOptions
+	}
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolume",
+		"metadata": map[string]interface{}{
+			"name":   name,
+			"labels": map[string]interface{}{"juicefs-name": c.Name},
+		},
+		"spec": spec,
+	}
+}
@@ -0,0 +1,23 @@
+package juicefs
+
+import (
+	"testing"
+)
+
+func TestCSIConfigSecretData(t *testing.T) {
+	c := CSIConfig{
+		Name:  "myjfs",
+		Token: "token",
+		Envs:  map[string]string{"TZ": "UTC"},
+	}
+	data, err := c.SecretData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["name"] != "myjfs" || data["token"] != "token" || data["envs"] != `{"TZ":"UTC"}` {
+		t.Errorf("unexpected secret data %v", data)
+	}
+	if _, ok := data["access-key"]; ok {
+		t.Errorf("access-key should be omitted when empty")
+	}
+}
@@ -0,0 +1,7 @@
+package juicefs
+
+import "errors"
+
+// ErrNotFound is wrapped by errors returned for objects that no longer exist,
+// so resources can drop them from state.
+var ErrNotFound = errors.New("not found")
@@ -0,0 +1,85 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// VolumeExport is an access rule of a volume, as managed through the exports
+// endpoints.
+type VolumeExport struct {
+	Id         int64  `json:"id"`
+	Desc       string `json:"desc"`
+	IpRange    string `json:"iprange"`
+	Token      string `json:"token"`
+	ApiOnly    bool   `json:"apionly"`
+	ReadOnly   bool   `json:"readonly"`
+	AppendOnly bool   `json:"appendonly"`
+}
+
+type VolumeExportRequest struct {
+	Desc       string `json:"desc"`
+	IpRange    string `json:"iprange"`
+	ApiOnly    bool   `json:"apionly"`
+	ReadOnly   bool   `json:"readonly"`
+	AppendOnly bool   `json:"appendonly"`
+}
+
+func (c *Client) GetVolumeExports(volumeID int64) ([]VolumeExport, error) {
+	u := fmt.Sprintf("%s/volumes/%d/exports", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume %d exports, statusCode: %d, error: %s", volumeID, statusCode, string(body))
+	}
+	var exports []VolumeExport
+	if err := json.Unmarshal(body, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+func (c *Client) CreateVolumeExport(volumeID int64, req VolumeExportRequest) (*VolumeExport, error) {
+	u := fmt.Sprintf("%s/volumes/%d/exports", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create volume export, status code %d, error %s", statusCode, body)
+	}
+	successRet := &VolumeExport{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateVolumeExport(volumeID int64, exportID int64, req VolumeExportRequest) (*VolumeExport, error) {
+	u := fmt.Sprintf("%s/volumes/%d/exports/%d", c.Endpoint, volumeID, exportID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update volume export %d, status code %d, error %s", exportID, statusCode, body)
+	}
+	successRet := &VolumeExport{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteVolumeExport(volumeID int64, exportID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/exports/%d", c.Endpoint, volumeID, exportID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete volume export %d, status code %d, error %s", exportID, statusCode, body)
+	}
+	return nil
+}
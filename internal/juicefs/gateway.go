@@ -0,0 +1,88 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Status values reported for S3 gateways.
+const (
+	S3GatewayStatusRunning = "running"
+	S3GatewayStatusFailed  = "failed"
+)
+
+type S3Gateway struct {
+	Id        int64  `json:"id"`
+	Volume    int64  `json:"volume"`
+	Region    int64  `json:"region"`
+	AccessKey string `json:"access_key"`
+	ReadOnly  bool   `json:"readonly"`
+	Subpath   string `json:"subpath"`
+	Endpoint  string `json:"endpoint"`
+	Status    string `json:"status"`
+}
+
+type S3GatewayRequest struct {
+	Region    int64   `json:"region,omitempty"`
+	AccessKey string  `json:"access_key"`
+	SecretKey string  `json:"secret_key"`
+	ReadOnly  bool    `json:"readonly"`
+	Subpath   *string `json:"subpath,omitempty"`
+}
+
+func (c *Client) CreateS3Gateway(volumeID int64, req S3GatewayRequest) (*S3Gateway, error) {
+	u := fmt.Sprintf("%s/volumes/%d/s3gateways", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create s3 gateway, status code %d, error %s", statusCode, body)
+	}
+	successRet := &S3Gateway{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetS3Gateway(volumeID int64, gatewayID int64) (*S3Gateway, error) {
+	u := fmt.Sprintf("%s/volumes/%d/s3gateways/%d", c.Endpoint, volumeID, gatewayID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("s3 gateway %d %w", gatewayID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get s3 gateway %d, statusCode: %d, error: %s", gatewayID, statusCode, string(body))
+	}
+	successRet := &S3Gateway{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateS3Gateway(volumeID int64, gatewayID int64, req S3GatewayRequest) (*S3Gateway, error) {
+	u := fmt.Sprintf("%s/volumes/%d/s3gateways/%d", c.Endpoint, volumeID, gatewayID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update s3 gateway %d, status code %d, error %s", gatewayID, statusCode, body)
+	}
+	successRet := &S3Gateway{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteS3Gateway(volumeID int64, gatewayID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/s3gateways/%d", c.Endpoint, volumeID, gatewayID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete s3 gateway, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
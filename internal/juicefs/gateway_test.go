@@ -0,0 +1,56 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestS3Gateway(t *testing.T) {
+	var updated map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/volumes/42/s3gateways/7":
+			fmt.Fprint(w, `{"id":7,"volume":42,"region":1,"access_key":"gw","readonly":true,"subpath":"","endpoint":"https://s3.example.com","status":"running"}`)
+		case r.Method == "PUT" && r.URL.Path == "/volumes/42/s3gateways/7":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"id":7,"volume":42,"region":1,"access_key":"gw","subpath":"/","status":"running"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	gateway, err := c.GetS3Gateway(42, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gateway.ReadOnly || gateway.Subpath != "" || gateway.Status != S3GatewayStatusRunning {
+		t.Errorf("GetS3Gateway() = %+v", gateway)
+	}
+
+	subpath := "/"
+	if _, err := c.UpdateS3Gateway(42, 7, S3GatewayRequest{AccessKey: "gw", SecretKey: "key", Subpath: &subpath}); err != nil {
+		t.Fatal(err)
+	}
+	if updated["subpath"] != "/" || updated["readonly"] != false {
+		t.Errorf("UpdateS3Gateway() sent %v", updated)
+	}
+	if _, ok := updated["region"]; ok {
+		t.Errorf("UpdateS3Gateway() sent region %v, want it omitted", updated["region"])
+	}
+
+	if _, err := c.GetS3Gateway(42, 8); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetS3Gateway() error = %v, want ErrNotFound", err)
+	}
+	if err := c.DeleteS3Gateway(42, 8); err != nil {
+		t.Errorf("DeleteS3Gateway() of a missing gateway = %v, want nil", err)
+	}
+}
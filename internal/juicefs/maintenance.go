@@ -0,0 +1,117 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Operations supported by maintenance jobs.
+const (
+	MaintenanceOperationGC      = "gc"
+	MaintenanceOperationFsck    = "fsck"
+	MaintenanceOperationCompact = "compact"
+)
+
+// MaintenanceOperations lists the valid values of MaintenanceJobRequest.Operation.
+var MaintenanceOperations = []string{
+	MaintenanceOperationGC,
+	MaintenanceOperationFsck,
+	MaintenanceOperationCompact,
+}
+
+// Status values reported for the runs of maintenance jobs.
+const (
+	MaintenanceJobStatusSucceeded = "succeeded"
+	MaintenanceJobStatusFailed    = "failed"
+)
+
+type MaintenanceJob struct {
+	Id             int64      `json:"id"`
+	Volume         int64      `json:"volume"`
+	Operation      string     `json:"operation"`
+	Schedule       string     `json:"schedule"`
+	Status         string     `json:"status"`
+	LastRun        *time.Time `json:"last_run"`
+	LeakedObjects  int64      `json:"leaked_objects"`
+	BytesReclaimed int64      `json:"bytes_reclaimed"`
+	Error          string     `json:"error"`
+}
+
+type MaintenanceJobRequest struct {
+	Operation string `json:"operation"`
+	Schedule  string `json:"schedule"`
+}
+
+// CreateMaintenanceJob registers a maintenance job and starts its first run.
+func (c *Client) CreateMaintenanceJob(volumeID int64, req MaintenanceJobRequest) (*MaintenanceJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/maintenance", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create maintenance job, status code %d, error %s", statusCode, body)
+	}
+	successRet := &MaintenanceJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetMaintenanceJob(volumeID int64, jobID int64) (*MaintenanceJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/maintenance/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("maintenance job %d %w", jobID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get maintenance job %d, statusCode: %d, error: %s", jobID, statusCode, string(body))
+	}
+	successRet := &MaintenanceJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateMaintenanceJob(volumeID int64, jobID int64, req MaintenanceJobRequest) (*MaintenanceJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/maintenance/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update maintenance job %d, status code %d, error %s", jobID, statusCode, body)
+	}
+	successRet := &MaintenanceJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+// RunMaintenanceJob starts a run of the job outside of its schedule.
+func (c *Client) RunMaintenanceJob(volumeID int64, jobID int64) (*MaintenanceJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/maintenance/%d/run", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("POST", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to run maintenance job %d, status code %d, error %s", jobID, statusCode, body)
+	}
+	successRet := &MaintenanceJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteMaintenanceJob(volumeID int64, jobID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/maintenance/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete maintenance job, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
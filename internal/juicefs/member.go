@@ -0,0 +1,164 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Member struct {
+	Id     int64  `json:"id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+	Status string `json:"status"`
+}
+
+type MemberRequest struct {
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role"`
+}
+
+func (c *Client) GetMembers() ([]Member, error) {
+	u := fmt.Sprintf("%s/members", c.Endpoint)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("get members failed, status code: %d, body: %s", statusCode, body)
+	}
+	var members []Member
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (c *Client) GetMember(memberID int64) (*Member, error) {
+	u := fmt.Sprintf("%s/members/%d", c.Endpoint, memberID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("member %d %w", memberID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get member %d, statusCode: %d, error: %s", memberID, statusCode, string(body))
+	}
+	successRet := &Member{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+// InviteMember sends an invitation to join the organization to req.Email.
+func (c *Client) InviteMember(req MemberRequest) (*Member, error) {
+	u := fmt.Sprintf("%s/members", c.Endpoint)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to invite member, status code %d, error %s", statusCode, body)
+	}
+	successRet := &Member{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateMember(memberID int64, req MemberRequest) (*Member, error) {
+	u := fmt.Sprintf("%s/members/%d", c.Endpoint, memberID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update member %d, status code %d, error %s", memberID, statusCode, body)
+	}
+	successRet := &Member{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteMember(memberID int64) error {
+	u := fmt.Sprintf("%s/members/%d", c.Endpoint, memberID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete member, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
+
+// VolumePermission grants a role on a volume to either a member or a group.
+type VolumePermission struct {
+	Id     int64  `json:"id"`
+	Volume int64  `json:"volume"`
+	Member *int64 `json:"member"`
+	Group  *int64 `json:"group"`
+	Role   string `json:"role"`
+}
+
+type VolumePermissionRequest struct {
+	Member *int64 `json:"member,omitempty"`
+	Group  *int64 `json:"group,omitempty"`
+	Role   string `json:"role"`
+}
+
+func (c *Client) CreateVolumePermission(volumeID int64, req VolumePermissionRequest) (*VolumePermission, error) {
+	u := fmt.Sprintf("%s/volumes/%d/permissions", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create volume permission, status code %d, error %s", statusCode, body)
+	}
+	successRet := &VolumePermission{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetVolumePermission(volumeID int64, permissionID int64) (*VolumePermission, error) {
+	u := fmt.Sprintf("%s/volumes/%d/permissions/%d", c.Endpoint, volumeID, permissionID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume permission %d %w", permissionID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume permission %d, statusCode: %d, error: %s", permissionID, statusCode, string(body))
+	}
+	successRet := &VolumePermission{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateVolumePermission(volumeID int64, permissionID int64, req VolumePermissionRequest) (*VolumePermission, error) {
+	u := fmt.Sprintf("%s/volumes/%d/permissions/%d", c.Endpoint, volumeID, permissionID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update volume permission %d, status code %d, error %s", permissionID, statusCode, body)
+	}
+	successRet := &VolumePermission{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteVolumePermission(volumeID int64, permissionID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/permissions/%d", c.Endpoint, volumeID, permissionID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete volume permission, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMembers(t *testing.T) {
+	var invited MemberRequest
+	var granted map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/members":
+			fmt.Fprint(w, `[{"id":1,"email":"a@example.com","role":"admin","status":"active"},{"id":2,"email":"b@example.com","role":"member","status":"invited"}]`)
+		case r.Method == "POST" && r.URL.Path == "/members":
+			if err := json.NewDecoder(r.Body).Decode(&invited); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id":3,"email":%q,"role":%q,"status":"invited"}`, invited.Email, invited.Role)
+		case r.Method == "POST" && r.URL.Path == "/volumes/42/permissions":
+			if err := json.NewDecoder(r.Body).Decode(&granted); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":7,"volume":42,"member":3,"group":null,"role":"reader"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	members, err := c.GetMembers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Member{
+		{Id: 1, Email: "a@example.com", Role: "admin", Status: "active"},
+		{Id: 2, Email: "b@example.com", Role: "member", Status: "invited"},
+	}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("GetMembers() = %+v, want %+v", members, want)
+	}
+
+	member, err := c.InviteMember(MemberRequest{Email: "c@example.com", Role: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Id != 3 || member.Status != "invited" || invited.Email != "c@example.com" {
+		t.Errorf("InviteMember() = %+v, sent %+v", member, invited)
+	}
+
+	memberID := member.Id
+	permission, err := c.CreateVolumePermission(42, VolumePermissionRequest{Member: &memberID, Role: "reader"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := granted["group"]; ok || granted["member"] != float64(3) {
+		t.Errorf("CreateVolumePermission() sent %v, want only member", granted)
+	}
+	if permission.Member == nil || *permission.Member != 3 || permission.Group != nil {
+		t.Errorf("CreateVolumePermission() = %+v", permission)
+	}
+
+	if _, err := c.GetMember(9); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetMember() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.GetVolumePermission(42, 9); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetVolumePermission() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,67 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Metric names accepted by the volume metrics endpoint.
+const (
+	MetricUsedBytes  = "used_bytes"
+	MetricInodes     = "inodes"
+	MetricReadBytes  = "read_bytes"
+	MetricWriteBytes = "write_bytes"
+	MetricOps        = "ops"
+)
+
+type MetricPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+type MetricSeries struct {
+	Name   string        `json:"name"`
+	Points []MetricPoint `json:"points"`
+}
+
+type VolumeMetricsQuery struct {
+	Start   time.Time
+	End     time.Time
+	Step    time.Duration
+	Metrics []string
+}
+
+func (q VolumeMetricsQuery) values() url.Values {
+	params := url.Values{}
+	params.Set("start", strconv.FormatInt(q.Start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(q.End.Unix(), 10))
+	if q.Step > 0 {
+		params.Set("step", strconv.FormatInt(int64(q.Step/time.Second), 10))
+	}
+	for _, metric := range q.Metrics {
+		params.Add("metric", metric)
+	}
+	return params
+}
+
+func (c *Client) GetVolumeMetrics(volumeID int64, query VolumeMetricsQuery) ([]MetricSeries, error) {
+	u := fmt.Sprintf("%s/volumes/%d/metrics", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("GET", u, query.values(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume %d metrics, statusCode: %d, error: %s", volumeID, statusCode, string(body))
+	}
+	var series []MetricSeries
+	if err := json.Unmarshal(body, &series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
@@ -0,0 +1,66 @@
+package juicefs
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetVolumeMetrics(t *testing.T) {
+	c := &Client{AccessKey: "access", SecretKey: "secret"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/volumes/42/metrics" {
+			http.NotFound(w, r)
+			return
+		}
+		want := "end=1700003600&metric=used_bytes&metric=ops&start=1700000000&step=60"
+		if r.URL.RawQuery != want {
+			http.Error(w, fmt.Sprintf("query %q, want %q", r.URL.RawQuery, want), http.StatusBadRequest)
+			return
+		}
+		raw, err := base64.StdEncoding.DecodeString(r.Header.Get("Authorization"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		var auth struct {
+			Timestamp int64  `json:"timestamp"`
+			Signature string `json:"signature"`
+		}
+		if err := json.Unmarshal(raw, &auth); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		signature, err := c.sign(auth.Timestamp, r.Method, r.URL.Path, http.Header{"Host": {r.Host}}, r.URL.Query(), nil)
+		if err != nil || signature != auth.Signature {
+			http.Error(w, "signature mismatch", http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"used_bytes","points":[{"timestamp":1700000000,"value":1024}]},{"name":"ops","points":[]}]`)
+	}))
+	defer srv.Close()
+	c.Endpoint = srv.URL
+
+	query := VolumeMetricsQuery{
+		Start:   time.Unix(1700000000, 0),
+		End:     time.Unix(1700003600, 0),
+		Step:    time.Minute,
+		Metrics: []string{MetricUsedBytes, MetricOps},
+	}
+	series, err := c.GetVolumeMetrics(42, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != 2 || series[0].Name != MetricUsedBytes || len(series[0].Points) != 1 || series[0].Points[0].Value != 1024 {
+		t.Errorf("GetVolumeMetrics() = %+v", series)
+	}
+
+	if _, err := c.GetVolumeMetrics(1, query); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetVolumeMetrics() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,143 @@
+package juicefs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MountConfig holds what is needed to authenticate and mount a volume with
+// the juicefs client.
+type MountConfig struct {
+	Name       string
+	Token      string
+	AccessKey  string
+	SecretKey  string
+	Bucket     string
+	MountPoint string
+	CacheDir   string
+	CacheSize  int64
+	CacheGroup string
+	Writeback  bool
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+func shellQuote(s string) string {
+	if s != "" && shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (m MountConfig) AuthCommand() string {
+	args := []string{"juicefs", "auth", shellQuote(m.Name), "--token", shellQuote(m.Token)}
+	if m.AccessKey != "" {
+		args = append(args, "--access-key", shellQuote(m.AccessKey))
+	}
+	if m.SecretKey != "" {
+		args = append(args, "--secret-key", shellQuote(m.SecretKey))
+	}
+	if m.Bucket != "" {
+		args = append(args, "--bucket", shellQuote(m.Bucket))
+	}
+	return strings.Join(args, " ")
+}
+
+// Validate reports values that cannot be rendered: mount option values
+// cannot contain commas, which separate options, and no value can span lines.
+func (m MountConfig) Validate() error {
+	if strings.Contains(m.CacheDir, ",") {
+		return fmt.Errorf("cache dir %q cannot contain a comma", m.CacheDir)
+	}
+	if strings.Contains(m.CacheGroup, ",") {
+		return fmt.Errorf("cache group %q cannot contain a comma", m.CacheGroup)
+	}
+	for _, v := range []string{m.Name, m.MountPoint, m.CacheDir, m.CacheGroup} {
+		if strings.ContainsAny(v, "\r\n") {
+			return errors.New("mount config values cannot contain line breaks")
+		}
+	}
+	return nil
+}
+
+// fstabEscape escapes the characters that separate fields of fstab, the
+// same way getmntent(3) unescapes them.
+var fstabEscape = strings.NewReplacer(" ", `\040`, "\t", `\011`, `\`, `\134`)
+
+// systemdEscape escapes specifiers in unit file settings.
+var systemdEscape = strings.NewReplacer("%", "%%")
+
+// mountOptions returns the mount options as `key=value` pairs, in the form
+// used by fstab and systemd.
+func (m MountConfig) mountOptions() []string {
+	var opts []string
+	if m.CacheDir != "" {
+		opts = append(opts, "cache-dir="+m.CacheDir)
+	}
+	if m.CacheSize > 0 {
+		opts = append(opts, fmt.Sprintf("cache-size=%d", m.CacheSize))
+	}
+	if m.CacheGroup != "" {
+		opts = append(opts, "cache-group="+m.CacheGroup)
+	}
+	if m.Writeback {
+		opts = append(opts, "writeback")
+	}
+	return opts
+}
+
+func (m MountConfig) MountCommand() string {
+	args := []string{"juicefs", "mount", shellQuote(m.Name), shellQuote(m.MountPoint)}
+	for _, opt := range m.mountOptions() {
+		args = append(args, "--"+shellQuote(opt))
+	}
+	return strings.Join(args, " ")
+}
+
+func (m MountConfig) FstabLine() string {
+	opts := append([]string{"_netdev"}, m.mountOptions()...)
+	return fmt.Sprintf("%s %s juicefs %s 0 0", fstabEscape.Replace(m.Name), fstabEscape.Replace(m.MountPoint), fstabEscape.Replace(strings.Join(opts, ",")))
+}
+
+// SystemdUnitName returns the name systemd expects for a mount unit of the
+// mount point, e.g. `mnt-jfs.mount` for `/mnt/jfs`.
+func (m MountConfig) SystemdUnitName() string {
+	p := strings.Trim(m.MountPoint, "/")
+	if p == "" {
+		return "-.mount"
+	}
+	var b strings.Builder
+	for i, c := range []byte(p) {
+		switch {
+		case c == '/':
+			b.WriteByte('-')
+		case c == '.' && i == 0:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == ':':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		}
+	}
+	return b.String() + ".mount"
+}
+
+func (m MountConfig) SystemdUnit() string {
+	opts := append([]string{"_netdev"}, m.mountOptions()...)
+	return fmt.Sprintf(`[Unit]
+Description=JuiceFS volume %s
+Wants=network-online.target
+After=network-online.target
+
+[Mount]
+What=%s
+Where=%s
+Type=juicefs
+Options=%s
+
+[Install]
+WantedBy=remote-fs.target
+`, systemdEscape.Replace(m.Name), systemdEscape.Replace(m.Name), systemdEscape.Replace(m.MountPoint), systemdEscape.Replace(strings.Join(opts, ",")))
+}
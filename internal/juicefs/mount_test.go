@@ -0,0 +1,76 @@
+package juicefs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMountConfig(t *testing.T) {
+	m := MountConfig{
+		Name:       "myjfs",
+		Token:      "abc'def",
+		MountPoint: "/mnt/jfs",
+		CacheDir:   "/var/jfsCache",
+		CacheSize:  102400,
+		CacheGroup: "web",
+		Writeback:  true,
+	}
+
+	if got, want := m.AuthCommand(), `juicefs auth myjfs --token 'abc'\''def'`; got != want {
+		t.Errorf("AuthCommand() = %q, want %q", got, want)
+	}
+	if got, want := m.MountCommand(), "juicefs mount myjfs /mnt/jfs --cache-dir=/var/jfsCache --cache-size=102400 --cache-group=web --writeback"; got != want {
+		t.Errorf("MountCommand() = %q, want %q", got, want)
+	}
+	if got, want := m.FstabLine(), "myjfs /mnt/jfs juicefs _netdev,cache-dir=/var/jfsCache,cache-size=102400,cache-group=web,writeback 0 0"; got != want {
+		t.Errorf("FstabLine() = %q, want %q", got, want)
+	}
+}
+
+func TestMountConfigSystemdUnitName(t *testing.T) {
+	cases := map[string]string{
+		"/":            "-.mount",
+		"/jfs":         "jfs.mount",
+		"/mnt/jfs/":    "mnt-jfs.mount",
+		"/mnt/my-data": `mnt-my\x2ddata.mount`,
+	}
+	for mountPoint, want := range cases {
+		got := MountConfig{MountPoint: mountPoint}.SystemdUnitName()
+		if got != want {
+			t.Errorf("SystemdUnitName(%q) = %q, want %q", mountPoint, got, want)
+		}
+	}
+}
+
+func TestMountConfigEscaping(t *testing.T) {
+	m := MountConfig{
+		Name:       "myjfs",
+		MountPoint: "/mnt/my data",
+		CacheDir:   "/var/cache 100%",
+	}
+	if err := m.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	if got, want := m.FstabLine(), `myjfs /mnt/my\040data juicefs _netdev,cache-dir=/var/cache\040100% 0 0`; got != want {
+		t.Errorf("FstabLine() = %q, want %q", got, want)
+	}
+	unit := m.SystemdUnit()
+	for _, want := range []string{"\nWhere=/mnt/my data\n", "\nOptions=_netdev,cache-dir=/var/cache 100%%\n"} {
+		if !strings.Contains(unit, want) {
+			t.Errorf("SystemdUnit() = %q, want it to contain %q", unit, want)
+		}
+	}
+}
+
+func TestMountConfigValidate(t *testing.T) {
+	cases := map[string]MountConfig{
+		"comma in cache dir":   {Name: "myjfs", MountPoint: "/jfs", CacheDir: "/a,/b"},
+		"comma in cache group": {Name: "myjfs", MountPoint: "/jfs", CacheGroup: "web,db"},
+		"newline in mount":     {Name: "myjfs", MountPoint: "/jfs\nExecStart=/bin/sh"},
+	}
+	for name, m := range cases {
+		if err := m.Validate(); err == nil {
+			t.Errorf("%s: Validate() succeeded, want error", name)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Types of notification channels.
+const (
+	NotificationChannelEmail   = "email"
+	NotificationChannelWebhook = "webhook"
+	NotificationChannelSlack   = "slack"
+)
+
+// NotificationChannelTypes lists the valid values of NotificationChannel.Type.
+var NotificationChannelTypes = []string{
+	NotificationChannelEmail,
+	NotificationChannelWebhook,
+	NotificationChannelSlack,
+}
+
+type NotificationChannel struct {
+	Id     int64    `json:"id"`
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	Emails []string `json:"emails"`
+	URL    string   `json:"url"`
+}
+
+type NotificationChannelRequest struct {
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	Emails []string `json:"emails,omitempty"`
+	URL    string   `json:"url,omitempty"`
+}
+
+func (c *Client) CreateNotificationChannel(req NotificationChannelRequest) (*NotificationChannel, error) {
+	u := fmt.Sprintf("%s/notification_channels", c.Endpoint)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create notification channel, status code %d, error %s", statusCode, body)
+	}
+	successRet := &NotificationChannel{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetNotificationChannel(channelID int64) (*NotificationChannel, error) {
+	u := fmt.Sprintf("%s/notification_channels/%d", c.Endpoint, channelID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("notification channel %d %w", channelID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get notification channel %d, statusCode: %d, error: %s", channelID, statusCode, string(body))
+	}
+	successRet := &NotificationChannel{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateNotificationChannel(channelID int64, req NotificationChannelRequest) (*NotificationChannel, error) {
+	u := fmt.Sprintf("%s/notification_channels/%d", c.Endpoint, channelID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update notification channel %d, status code %d, error %s", channelID, statusCode, body)
+	}
+	successRet := &NotificationChannel{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteNotificationChannel(channelID int64) error {
+	u := fmt.Sprintf("%s/notification_channels/%d", c.Endpoint, channelID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete notification channel, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
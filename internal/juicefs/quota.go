@@ -0,0 +1,55 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// VolumeQuota limits the bytes and inodes under a directory of a volume. A
+// zero limit means unlimited.
+type VolumeQuota struct {
+	Id         int64  `json:"id"`
+	Path       string `json:"path"`
+	Size       int64  `json:"size"`
+	Inodes     int64  `json:"inodes"`
+	UsedSize   int64  `json:"used_size"`
+	UsedInodes int64  `json:"used_inodes"`
+}
+
+func usagePercent(used int64, limit int64) (float64, bool) {
+	if limit <= 0 {
+		return 0, false
+	}
+	return float64(used) * 100 / float64(limit), true
+}
+
+// SizePercent returns the used bytes as a percentage of the size limit, and
+// false if the size is unlimited.
+func (q VolumeQuota) SizePercent() (float64, bool) {
+	return usagePercent(q.UsedSize, q.Size)
+}
+
+// InodesPercent returns the used inodes as a percentage of the inode limit,
+// and false if inodes are unlimited.
+func (q VolumeQuota) InodesPercent() (float64, bool) {
+	return usagePercent(q.UsedInodes, q.Inodes)
+}
+
+func (c *Client) GetVolumeQuotas(volumeID int64) ([]VolumeQuota, error) {
+	u := fmt.Sprintf("%s/volumes/%d/quotas", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume %d quotas, statusCode: %d, error: %s", volumeID, statusCode, string(body))
+	}
+	var quotas []VolumeQuota
+	if err := json.Unmarshal(body, &quotas); err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
@@ -0,0 +1,15 @@
+package juicefs
+
+import (
+	"testing"
+)
+
+func TestVolumeQuotaPercent(t *testing.T) {
+	q := VolumeQuota{Size: 1 << 30, UsedSize: 768 << 20, UsedInodes: 10}
+	if p, ok := q.SizePercent(); !ok || p != 75 {
+		t.Errorf("SizePercent() = %v, %v, want 75, true", p, ok)
+	}
+	if p, ok := q.InodesPercent(); ok {
+		t.Errorf("InodesPercent() = %v, %v for an unlimited quota, want false", p, ok)
+	}
+}
@@ -0,0 +1,81 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type VolumeReplica struct {
+	Id      int64     `json:"id"`
+	Volume  int64     `json:"volume"`
+	Region  int64     `json:"region"`
+	Status  string    `json:"status"`
+	Lag     int64     `json:"lag"`
+	Created time.Time `json:"created"`
+}
+
+type CreateVolumeReplicaRequest struct {
+	Region int64 `json:"region"`
+}
+
+func (c *Client) CreateVolumeReplica(volumeID int64, req CreateVolumeReplicaRequest) (*VolumeReplica, error) {
+	u := fmt.Sprintf("%s/volumes/%d/replicas", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create volume replica, status code %d, error %s", statusCode, body)
+	}
+	successRet := &VolumeReplica{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetVolumeReplica(volumeID int64, replicaID int64) (*VolumeReplica, error) {
+	u := fmt.Sprintf("%s/volumes/%d/replicas/%d", c.Endpoint, volumeID, replicaID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume replica %d %w", replicaID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume replica %d, statusCode: %d, error: %s", replicaID, statusCode, string(body))
+	}
+	successRet := &VolumeReplica{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) DeleteVolumeReplica(volumeID int64, replicaID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/replicas/%d", c.Endpoint, volumeID, replicaID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete volume replica, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
+
+func (c *Client) IsVolumeReplicaReady(volumeID int64, replicaID int64) (bool, error) {
+	u := fmt.Sprintf("%s/volumes/%d/replicas/%d/is_ready", c.Endpoint, volumeID, replicaID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	if statusCode != 200 {
+		return false, fmt.Errorf("failed to check volume replica ready, status code %d, error %s", statusCode, body)
+	}
+
+	var successRet struct {
+		IsReady bool `json:"is_ready"`
+	}
+
+	err = json.Unmarshal(body, &successRet)
+	return successRet.IsReady, err
+}
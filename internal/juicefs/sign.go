@@ -20,6 +20,9 @@ type Client struct {
 	Endpoint  string
 	AccessKey string
 	SecretKey string
+	// Transport is shared by all requests of the client, nil to use
+	// http.DefaultTransport.
+	Transport http.RoundTripper
 }
 
 // 参数说明：
@@ -106,9 +109,8 @@ func (c *Client) request(
 	data interface{},
 ) (int, []byte, error) {
 	var (
-		body         []byte
-		err          error
-		requestBytes []byte
+		body []byte
+		err  error
 	)
 	timestamp := time.Now().Unix()
 	reqUrlWithParam := fmt.Sprintf("%s?%s", path, queryParams.Encode())
@@ -125,23 +127,10 @@ func (c *Client) request(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Host", req.URL.Host)
 
-	fmt.Printf("secret_key: %s...%s\n", c.SecretKey[:10], c.SecretKey[len(c.SecretKey)-10:])
-	fmt.Printf("timestamp: %d\n", timestamp)
-	fmt.Printf("method: %s\n", method)
-	fmt.Printf("path: %s\n", req.URL.Path)
-	requestBytes, err = json.Marshal(req.Header)
-	if err != nil {
-		return 0, nil, err
-	}
-	fmt.Printf("headers: %s\n", string(requestBytes))
-	fmt.Printf("query_params: %v\n", req.URL.RawQuery)
-	fmt.Printf("body: %s\n", body)
-
 	signature, err := c.sign(timestamp, method, req.URL.Path, req.Header, queryParams, body)
 	if err != nil {
 		return 0, nil, err
 	}
-	fmt.Printf("signature: %s\n", signature)
 
 	auth := map[string]interface{}{
 		"access_key": c.AccessKey,
@@ -154,11 +143,10 @@ func (c *Client) request(
 		return 0, nil, err
 	}
 	token := base64.StdEncoding.EncodeToString(jsonString)
-	fmt.Printf("token: %s\n", token)
 
 	req.Header.Set("Authorization", token)
 
-	client := http.Client{}
+	client := http.Client{Transport: c.Transport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return 0, nil, err
@@ -170,41 +158,9 @@ func (c *Client) request(
 	if err != nil {
 		return 0, nil, err
 	}
-	fmt.Printf("response: \n%s\n", string(respBody))
 	return resp.StatusCode, respBody, nil
 }
 
-//func (c *Client) GetVolumeExports() error {
-//	u := fmt.Sprintf("%s/volumes/1/exports", c.Endpoint)
-//	return c.request("GET", u, nil, nil)
-//}
-//
-//func (c *Client) CreateVolumeExport() error {
-//	u := fmt.Sprintf("%s/volumes/1/exports", c.Endpoint)
-//	return c.request(
-//		"POST",
-//		u,
-//		nil,
-//		map[string]interface{}{
-//			"desc":       "for mount",
-//			"iprange":    "192.168.0.1/24",
-//			"apionly":    false,
-//			"readonly":   false,
-//			"appendonly": false,
-//		},
-//	)
-//}
-//
-//func (c *Client) UpdateVolumeExport() error {
-//	u := fmt.Sprintf("%s/volumes/1/exports/1", c.Endpoint)
-//	return c.request("PUT", u, nil, map[string]interface{}{"desc": "abc", "iprange": "192.168.100.1/24"})
-//}
-//
-//func (c *Client) GetVolumeQuotas() error {
-//	u := fmt.Sprintf("%s/volumes/1/quotas", c.Endpoint)
-//	return c.request("GET", u, nil, nil)
-//}
-//
 //func (c *Client) CreateVolumeQuota() error {
 //	u := fmt.Sprintf("%s/volumes/1/quotas", c.Endpoint)
 //	return c.request(
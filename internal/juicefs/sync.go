@@ -0,0 +1,101 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Status values reported for sync jobs.
+const (
+	SyncJobStatusSucceeded = "succeeded"
+	SyncJobStatusFailed    = "failed"
+)
+
+type SyncJob struct {
+	Id          int64    `json:"id"`
+	Volume      int64    `json:"volume"`
+	Source      string   `json:"source"`
+	Path        string   `json:"path"`
+	Include     []string `json:"include"`
+	Exclude     []string `json:"exclude"`
+	Threads     int64    `json:"threads"`
+	DeleteDst   bool     `json:"delete_dst"`
+	Schedule    string   `json:"schedule"`
+	Status      string   `json:"status"`
+	BytesCopied int64    `json:"bytes_copied"`
+	FilesCopied int64    `json:"files_copied"`
+	Error       string   `json:"error"`
+}
+
+// SyncJobRequest describes a sync job. The source credentials are write-only
+// and never returned by the API.
+type SyncJobRequest struct {
+	Source          string   `json:"source"`
+	SourceAccessKey string   `json:"source_access_key,omitempty"`
+	SourceSecretKey string   `json:"source_secret_key,omitempty"`
+	Path            string   `json:"path"`
+	Include         []string `json:"include"`
+	Exclude         []string `json:"exclude"`
+	Threads         int64    `json:"threads,omitempty"`
+	DeleteDst       bool     `json:"delete_dst"`
+	Schedule        string   `json:"schedule"`
+}
+
+func (c *Client) CreateSyncJob(volumeID int64, req SyncJobRequest) (*SyncJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/sync_jobs", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create sync job, status code %d, error %s", statusCode, body)
+	}
+	successRet := &SyncJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetSyncJob(volumeID int64, jobID int64) (*SyncJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/sync_jobs/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("sync job %d %w", jobID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get sync job %d, statusCode: %d, error: %s", jobID, statusCode, string(body))
+	}
+	successRet := &SyncJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) UpdateSyncJob(volumeID int64, jobID int64, req SyncJobRequest) (*SyncJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/sync_jobs/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("PUT", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update sync job %d, status code %d, error %s", jobID, statusCode, body)
+	}
+	successRet := &SyncJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+// DeleteSyncJob cancels the job if it is running and removes its schedule.
+// Data already copied is kept.
+func (c *Client) DeleteSyncJob(volumeID int64, jobID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/sync_jobs/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to delete sync job, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateClearsSchedule(t *testing.T) {
+	bodies := map[string]map[string]any{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			http.NotFound(w, r)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body := map[string]any{}
+		if err := json.Unmarshal(data, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		bodies[r.URL.Path] = body
+		switch r.URL.Path {
+		case "/volumes/42/sync_jobs/7":
+			fmt.Fprint(w, `{"id":7,"volume":42,"source":"s3://bucket","schedule":""}`)
+		case "/volumes/42/maintenance/8":
+			fmt.Fprint(w, `{"id":8,"volume":42,"operation":"gc","schedule":""}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	if _, err := c.UpdateSyncJob(42, 7, SyncJobRequest{Source: "s3://bucket"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateMaintenanceJob(42, 8, MaintenanceJobRequest{Operation: "gc"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/volumes/42/sync_jobs/7", "/volumes/42/maintenance/8"} {
+		schedule, ok := bodies[path]["schedule"]
+		if !ok || schedule != "" {
+			t.Errorf("%s: schedule = %v (sent %v), want empty string", path, schedule, ok)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MountToken is a temporary token for mounting a volume, scoped to the
+// access rule matching IpRange.
+type MountToken struct {
+	Id      int64     `json:"id"`
+	IpRange string    `json:"iprange"`
+	Token   string    `json:"token"`
+	Expires time.Time `json:"expires"`
+}
+
+type CreateMountTokenRequest struct {
+	IpRange string `json:"iprange,omitempty"`
+	TTL     int64  `json:"ttl"`
+}
+
+func (c *Client) CreateMountToken(volumeID int64, req CreateMountTokenRequest) (*MountToken, error) {
+	u := fmt.Sprintf("%s/volumes/%d/tokens", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to create mount token, status code %d, error %s", statusCode, body)
+	}
+	successRet := &MountToken{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) RevokeMountToken(volumeID int64, tokenID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/tokens/%d", c.Endpoint, volumeID, tokenID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to revoke mount token, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package juicefs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportOptions configures how the client connects to the API, for
+// consoles deployed behind a proxy or with an internal certificate authority.
+type TransportOptions struct {
+	// CACertPEM holds PEM encoded certificates trusted in addition to the
+	// system roots.
+	CACertPEM string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from HTTPS_PROXY and related
+	// environment variables.
+	ProxyURL string
+	// ClientCertPEM and ClientKeyPEM hold the certificate and key presented
+	// to the server for mutual TLS. Both or neither must be set.
+	ClientCertPEM string
+	ClientKeyPEM  string
+}
+
+// NewTransport returns an http.Transport for the options. Without any option
+// set it behaves like http.DefaultTransport, honoring proxy environment
+// variables.
+func NewTransport(opts TransportOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url %q: %w", opts.ProxyURL, err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q: scheme and host are required", opts.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+	if opts.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(opts.CACertPEM)) {
+			return nil, errors.New("no valid certificate found in CA certificate PEM")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if opts.ClientCertPEM != "" || opts.ClientKeyPEM != "" {
+		if opts.ClientCertPEM == "" || opts.ClientKeyPEM == "" {
+			return nil, errors.New("client certificate and key must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(opts.ClientCertPEM), []byte(opts.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
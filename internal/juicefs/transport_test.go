@@ -0,0 +1,109 @@
+package juicefs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// selfSignedPEM returns a self-signed client certificate and its key.
+func selfSignedPEM(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestNewTransportTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	tests := []struct {
+		name    string
+		opts    TransportOptions
+		wantErr bool
+	}{
+		{"default", TransportOptions{}, true},
+		{"ca", TransportOptions{CACertPEM: caPEM}, false},
+		{"insecure", TransportOptions{InsecureSkipVerify: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := NewTransport(tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret", Transport: transport}
+			code, _, err := c.request("GET", srv.URL+"/regions", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("request() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && code != http.StatusNoContent {
+				t.Errorf("request() status = %d", code)
+			}
+		})
+	}
+}
+
+func TestNewTransportOptions(t *testing.T) {
+	certPEM, keyPEM := selfSignedPEM(t)
+
+	transport, err := NewTransport(TransportOptions{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(transport.TLSClientConfig.Certificates); got != 1 {
+		t.Errorf("client certificates = %d, want 1", got)
+	}
+
+	transport, err = NewTransport(TransportOptions{ProxyURL: "http://proxy.internal:3128"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", "https://juicefs.com/api/v1/regions", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.internal:3128" {
+		t.Errorf("proxy = %v, %v", proxy, err)
+	}
+
+	for name, opts := range map[string]TransportOptions{
+		"bad ca":       {CACertPEM: "not a certificate"},
+		"cert only":    {ClientCertPEM: certPEM},
+		"key mismatch": {ClientCertPEM: certPEM, ClientKeyPEM: "not a key"},
+		"bad proxy":    {ProxyURL: "proxy.internal"},
+	} {
+		if _, err := NewTransport(opts); err == nil {
+			t.Errorf("%s: NewTransport() succeeded, want error", name)
+		}
+	}
+}
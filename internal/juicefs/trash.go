@@ -0,0 +1,66 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type TrashEntry struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	Deleted time.Time `json:"deleted"`
+}
+
+func (c *Client) GetVolumeTrash(volumeID int64) ([]TrashEntry, error) {
+	u := fmt.Sprintf("%s/volumes/%d/trash", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume %d trash, statusCode: %d, error: %s", volumeID, statusCode, string(body))
+	}
+	var entries []TrashEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// RestoreVolumeTrash moves the trash entries at paths back to where they were
+// deleted from.
+func (c *Client) RestoreVolumeTrash(volumeID int64, paths []string) error {
+	u := fmt.Sprintf("%s/volumes/%d/trash/restore", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, map[string]interface{}{"paths": paths})
+	if err != nil {
+		return err
+	}
+	if statusCode == 404 {
+		return fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return fmt.Errorf("failed to restore volume %d trash, status code %d, error %s", volumeID, statusCode, body)
+	}
+	return nil
+}
+
+// PurgeVolumeTrash permanently deletes the trash entries deleted more than
+// days ago.
+func (c *Client) PurgeVolumeTrash(volumeID int64, days int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/trash/purge", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, map[string]interface{}{"days": days})
+	if err != nil {
+		return err
+	}
+	if statusCode == 404 {
+		return fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 && statusCode != 202 {
+		return fmt.Errorf("failed to purge volume %d trash, status code %d, error %s", volumeID, statusCode, body)
+	}
+	return nil
+}
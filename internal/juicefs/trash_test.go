@@ -0,0 +1,82 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestVolumeTrash(t *testing.T) {
+	var restored []string
+	var purgedDays int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/volumes/42/trash":
+			fmt.Fprint(w, `[{"path":"/a.txt","size":1024,"deleted":"2024-01-02T03:04:05Z"},{"path":"/b","size":0,"deleted":"2024-01-03T00:00:00Z"}]`)
+		case r.Method == "POST" && r.URL.Path == "/volumes/42/trash/restore":
+			var req struct {
+				Paths []string `json:"paths"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			restored = req.Paths
+		case r.Method == "POST" && r.URL.Path == "/volumes/42/trash/purge":
+			var req struct {
+				Days int64 `json:"days"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			purgedDays = req.Days
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	entries, err := c.GetVolumeTrash(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TrashEntry{
+		{Path: "/a.txt", Size: 1024, Deleted: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{Path: "/b", Size: 0, Deleted: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("GetVolumeTrash() = %+v, want %+v", entries, want)
+	}
+
+	if err := c.RestoreVolumeTrash(42, []string{"/a.txt"}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored, []string{"/a.txt"}) {
+		t.Errorf("restored paths = %v", restored)
+	}
+
+	if err := c.PurgeVolumeTrash(42, 7); err != nil {
+		t.Fatal(err)
+	}
+	if purgedDays != 7 {
+		t.Errorf("purged days = %d, want 7", purgedDays)
+	}
+
+	if _, err := c.GetVolumeTrash(1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetVolumeTrash() error = %v, want ErrNotFound", err)
+	}
+	if err := c.RestoreVolumeTrash(1, []string{"/a.txt"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RestoreVolumeTrash() error = %v, want ErrNotFound", err)
+	}
+	if err := c.PurgeVolumeTrash(1, 7); !errors.Is(err, ErrNotFound) {
+		t.Errorf("PurgeVolumeTrash() error = %v, want ErrNotFound", err)
+	}
+}
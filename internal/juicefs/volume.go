@@ -31,6 +31,7 @@ type Volume struct {
 	Compatible  bool                `json:"compatible"`
 	Extend      *string             `json:"extend"`
 	Storage     *string             `json:"storage"`
+	Lineage     *VolumeLineage      `json:"lineage"`
 }
 
 func (c *Client) GetVolumes() ([]Volume, error) {
@@ -96,7 +97,7 @@ func (c *Client) GetVolume(volumeID int64) (*Volume, error) {
 		return nil, err
 	}
 	if statusCode == 404 {
-		return nil, fmt.Errorf("volume %d not found", volumeID)
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
 	}
 	if statusCode != 200 {
 		return nil, fmt.Errorf("failed to get volume %d, statusCode: %d, error: %s", volumeID, statusCode, string(body))
@@ -106,6 +107,32 @@ func (c *Client) GetVolume(volumeID int64) (*Volume, error) {
 	return successRet, err
 }
 
+// UpdateVolumeRequest holds the volume settings that can be changed after
+// creation. Nil fields are left unchanged.
+type UpdateVolumeRequest struct {
+	Name       *string `json:"name,omitempty"`
+	TrashTime  *int64  `json:"trash_time,omitempty"`
+	Compatible *bool   `json:"compatible,omitempty"`
+	Storage    *string `json:"storage,omitempty"`
+}
+
+func (c *Client) UpdateVolume(volumeID int64, req UpdateVolumeRequest) (*Volume, error) {
+	u := fmt.Sprintf("%s/volumes/%d", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("PATCH", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to update volume %d, status code %d, error %s", volumeID, statusCode, body)
+	}
+	successRet := &Volume{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
 func (c *Client) DeleteVolume(volumeID int64) error {
 	u := fmt.Sprintf("%s/volumes/%d", c.Endpoint, volumeID)
 	statusCode, body, err := c.request("DELETE", u, nil, nil)
@@ -135,3 +162,27 @@ func (c *Client) IsVolumeReady(volumeID int64) (bool, error) {
 	err = json.Unmarshal(body, &successRet)
 	return successRet.IsReady, err
 }
+
+type VolumeUsage struct {
+	Size      int64 `json:"size"`
+	Inodes    int64 `json:"inodes"`
+	TrashSize int64 `json:"trash_size"`
+	Objects   int64 `json:"objects"`
+}
+
+func (c *Client) GetVolumeUsage(volumeID int64) (*VolumeUsage, error) {
+	u := fmt.Sprintf("%s/volumes/%d/usage", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("volume %d %w", volumeID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get volume %d usage, statusCode: %d, error: %s", volumeID, statusCode, string(body))
+	}
+	successRet := &VolumeUsage{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
@@ -0,0 +1,65 @@
+package juicefs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVolumeNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	if _, err := c.GetVolume(1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetVolume() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.UpdateVolume(1, UpdateVolumeRequest{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("UpdateVolume() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.GetVolumeUsage(1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetVolumeUsage() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUpdateVolumeSendsSetFields(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/volumes/1" {
+			http.NotFound(w, r)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		got = string(body)
+		fmt.Fprint(w, `{"id":1,"name":"data"}`)
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}
+
+	name, trashTime, compatible, storage := "logs", int64(3), false, "gs"
+	tests := []struct {
+		name string
+		req  UpdateVolumeRequest
+		want string
+	}{
+		{"empty", UpdateVolumeRequest{}, `{}`},
+		{"trash_time", UpdateVolumeRequest{TrashTime: &trashTime}, `{"trash_time":3}`},
+		{"compatible false", UpdateVolumeRequest{Compatible: &compatible}, `{"compatible":false}`},
+		{"all", UpdateVolumeRequest{Name: &name, TrashTime: &trashTime, Compatible: &compatible, Storage: &storage}, `{"name":"logs","trash_time":3,"compatible":false,"storage":"gs"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.UpdateVolume(1, tt.req); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("UpdateVolume() body = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
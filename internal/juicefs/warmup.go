@@ -0,0 +1,75 @@
+package juicefs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Status values reported for warmup jobs.
+const (
+	WarmupJobStatusSucceeded = "succeeded"
+	WarmupJobStatusFailed    = "failed"
+)
+
+type WarmupJob struct {
+	Id          int64    `json:"id"`
+	Volume      int64    `json:"volume"`
+	Paths       []string `json:"paths"`
+	CacheGroup  string   `json:"cache_group"`
+	Concurrency int64    `json:"concurrency"`
+	Status      string   `json:"status"`
+	Progress    float64  `json:"progress"`
+	BytesWarmed int64    `json:"bytes_warmed"`
+	Error       string   `json:"error"`
+}
+
+type SubmitWarmupJobRequest struct {
+	Paths       []string `json:"paths"`
+	CacheGroup  string   `json:"cache_group,omitempty"`
+	Concurrency int64    `json:"concurrency,omitempty"`
+}
+
+func (c *Client) SubmitWarmupJob(volumeID int64, req SubmitWarmupJobRequest) (*WarmupJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/warmup_jobs", c.Endpoint, volumeID)
+	statusCode, body, err := c.request("POST", u, nil, &req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 201 {
+		return nil, fmt.Errorf("failed to submit warmup job, status code %d, error %s", statusCode, body)
+	}
+	successRet := &WarmupJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+func (c *Client) GetWarmupJob(volumeID int64, jobID int64) (*WarmupJob, error) {
+	u := fmt.Sprintf("%s/volumes/%d/warmup_jobs/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("GET", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, fmt.Errorf("warmup job %d %w", jobID, ErrNotFound)
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("failed to get warmup job %d, statusCode: %d, error: %s", jobID, statusCode, string(body))
+	}
+	successRet := &WarmupJob{}
+	err = json.Unmarshal(body, successRet)
+	return successRet, err
+}
+
+// CancelWarmupJob stops a warmup job that is still running. Finished jobs are
+// left untouched.
+func (c *Client) CancelWarmupJob(volumeID int64, jobID int64) error {
+	u := fmt.Sprintf("%s/volumes/%d/warmup_jobs/%d", c.Endpoint, volumeID, jobID)
+	statusCode, body, err := c.request("DELETE", u, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != 204 && statusCode != 404 {
+		return fmt.Errorf("failed to cancel warmup job, status code %d, error %s", statusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AlertRuleResource{}
+var _ resource.ResourceWithImportState = &AlertRuleResource{}
+var _ resource.ResourceWithValidateConfig = &AlertRuleResource{}
+
+func NewAlertRuleResource() resource.Resource {
+	return &AlertRuleResource{}
+}
+
+// AlertRuleResource defines the resource implementation.
+type AlertRuleResource struct {
+	client *juicefs.Client
+}
+
+// AlertRuleResourceModel describes the resource data model.
+type AlertRuleResourceModel struct {
+	Id        types.Int64   `tfsdk:"id"`
+	Volume    types.Int64   `tfsdk:"volume"`
+	Name      types.String  `tfsdk:"name"`
+	Metric    types.String  `tfsdk:"metric"`
+	Threshold types.Float64 `tfsdk:"threshold"`
+	Duration  types.Int64   `tfsdk:"duration"`
+	Channels  types.Set     `tfsdk:"channels"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+}
+
+func (m AlertRuleResourceModel) toAPI(ctx context.Context) (juicefs.AlertRuleRequest, diag.Diagnostics) {
+	req := juicefs.AlertRuleRequest{
+		Name:      m.Name.ValueString(),
+		Metric:    m.Metric.ValueString(),
+		Threshold: m.Threshold.ValueFloat64(),
+		Duration:  m.Duration.ValueInt64(),
+		Channels:  []int64{},
+		Enabled:   m.Enabled.ValueBool(),
+	}
+	diags := m.Channels.ElementsAs(ctx, &req.Channels, false)
+	return req, diags
+}
+
+func (m *AlertRuleResourceModel) fromAPI(ctx context.Context, rule *juicefs.AlertRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Id = types.Int64Value(rule.Id)
+	m.Volume = types.Int64Value(rule.Volume)
+	m.Name = types.StringValue(rule.Name)
+	m.Metric = types.StringValue(rule.Metric)
+	m.Threshold = types.Float64Value(rule.Threshold)
+	m.Duration = types.Int64Value(rule.Duration)
+	channels := rule.Channels
+	if channels == nil {
+		channels = []int64{}
+	}
+	m.Channels, diags = types.SetValueFrom(ctx, types.Int64Type, channels)
+	m.Enabled = types.BoolValue(rule.Enabled)
+	return diags
+}
+
+func (r *AlertRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule"
+}
+
+func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Alert fired when a metric of a volume stays above a threshold",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Alert rule identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the alert rule",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"metric": schema.StringAttribute{
+				MarkdownDescription: "Metric to watch, one of `usage_percent`, `inode_usage_percent`, `trash_bytes` or `client_disconnects`",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"threshold": schema.Float64Attribute{
+				MarkdownDescription: "Value of the metric above which the alert fires",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "Seconds the metric must stay above the threshold before the alert fires, default to 300",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+			},
+			"channels": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Identifiers of the notification channels to notify",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alert rule is evaluated, default to true",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *AlertRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AlertRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Metric.IsUnknown() || data.Metric.IsNull() {
+		return
+	}
+	if !slices.Contains(juicefs.AlertMetrics, data.Metric.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metric"),
+			"Invalid Alert Metric",
+			fmt.Sprintf("Metric must be one of %s, got: %q.", strings.Join(juicefs.AlertMetrics, ", "), data.Metric.ValueString()),
+		)
+	}
+}
+
+func (r *AlertRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	rule, err := r.client.CreateAlertRule(volumeID, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert rule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("alert rule created: volume=%d ID=%d", volumeID, rule.Id))
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetAlertRule(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert rule, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.UpdateAlertRule(data.Volume.ValueInt64(), data.Id.ValueInt64(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert rule, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAlertRule(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert rule, got error: %s", err))
+		return
+	}
+}
+
+func (r *AlertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, ruleID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/alert_rule_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+}
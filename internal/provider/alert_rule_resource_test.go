@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+)
+
+func TestAlertRuleResourceModelFromAPI(t *testing.T) {
+	var m AlertRuleResourceModel
+	diags := m.fromAPI(context.Background(), &juicefs.AlertRule{Id: 1, Volume: 2, Metric: "usage_percent"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Channels.IsNull() || len(m.Channels.Elements()) != 0 {
+		t.Errorf("channels = %s, want an empty set", m.Channels)
+	}
+}
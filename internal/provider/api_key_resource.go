@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIKeyResource{}
+var _ resource.ResourceWithImportState = &APIKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &APIKeyResource{}
+}
+
+// APIKeyResource defines the resource implementation.
+type APIKeyResource struct {
+	client *juicefs.Client
+}
+
+// APIKeyResourceModel describes the resource data model.
+type APIKeyResourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Scopes      types.List   `tfsdk:"scopes"`
+	Volumes     types.List   `tfsdk:"volumes"`
+	Expires     types.String `tfsdk:"expires"`
+	AccessKey   types.String `tfsdk:"access_key"`
+	SecretKey   types.String `tfsdk:"secret_key"`
+	Created     types.String `tfsdk:"created"`
+}
+
+// fromAPI copies the key into the model. Optional arguments left unset stay
+// null when the API returns their zero value, and an expiration time is kept
+// as configured when it denotes the same instant.
+func (m *APIKeyResourceModel) fromAPI(ctx context.Context, key *juicefs.APIKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Id = types.Int64Value(key.Id)
+	m.AccessKey = types.StringValue(key.AccessKey)
+	m.Created = types.StringValue(key.Created.Format(time.RFC3339))
+	if key.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(key.Description)
+	}
+	if len(key.Scopes) > 0 || !m.Scopes.IsNull() {
+		scopes := key.Scopes
+		if scopes == nil {
+			scopes = []string{}
+		}
+		var d diag.Diagnostics
+		m.Scopes, d = types.ListValueFrom(ctx, types.StringType, scopes)
+		diags.Append(d...)
+	}
+	if len(key.Volumes) > 0 || !m.Volumes.IsNull() {
+		volumes := key.Volumes
+		if volumes == nil {
+			volumes = []int64{}
+		}
+		var d diag.Diagnostics
+		m.Volumes, d = types.ListValueFrom(ctx, types.Int64Type, volumes)
+		diags.Append(d...)
+	}
+	if key.Expires == nil {
+		m.Expires = types.StringNull()
+	} else if expires, err := time.Parse(time.RFC3339, m.Expires.ValueString()); m.Expires.IsNull() || err != nil || !expires.Equal(*key.Expires) {
+		m.Expires = types.StringValue(key.Expires.Format(time.RFC3339))
+	}
+	return diags
+}
+
+func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scoped API key. The secret key is only returned when the key is created, " +
+			"so every change replaces the key; use `create_before_destroy` to rotate it without downtime.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "API key identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the API key",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes granted to the API key, all scopes if unset",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"volumes": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Volumes the API key is restricted to, all volumes if unset",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Expiration time in RFC 3339 format, never expires if unset",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: "Access key",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "Secret key, only known when the key is created by Terraform",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the API key",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq := juicefs.CreateAPIKeyRequest{
+		Description: data.Description.ValueString(),
+	}
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &apiReq.Scopes, false)...)
+	}
+	if !data.Volumes.IsNull() {
+		resp.Diagnostics.Append(data.Volumes.ElementsAs(ctx, &apiReq.Volumes, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Expires.IsNull() {
+		expires, err := time.Parse(time.RFC3339, data.Expires.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires"), "Invalid Expiration Time", fmt.Sprintf("Unable to parse expires %q: %s", data.Expires.ValueString(), err))
+			return
+		}
+		apiReq.Expires = &expires
+	}
+
+	key, err := r.client.CreateAPIKey(apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create api key, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("api key created: ID=%d", key.Id))
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, &key.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SecretKey = types.StringValue(key.SecretKey)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read api key, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APIKeyResourceModel
+
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAPIKey(data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete api key, got error: %s", err))
+		return
+	}
+}
+
+func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected a numeric api key identifier. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.AddWarning(
+		"Secret Key Not Imported",
+		"The secret key of an API key is only returned when it is created, so secret_key stays empty after import.",
+	)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIKeyResourceModelFromAPI(t *testing.T) {
+	ctx := context.Background()
+	expires := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	key := &juicefs.APIKey{Id: 1, AccessKey: "ak", Expires: &expires, Created: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name        string
+		expires     types.String
+		apiExpires  *time.Time
+		wantExpires types.String
+	}{
+		{"same instant in another zone", types.StringValue("2030-01-02T11:04:05+08:00"), &expires, types.StringValue("2030-01-02T11:04:05+08:00")},
+		{"different instant", types.StringValue("2031-01-01T00:00:00Z"), &expires, types.StringValue("2030-01-02T03:04:05Z")},
+		{"not configured", types.StringNull(), &expires, types.StringValue("2030-01-02T03:04:05Z")},
+		{"never expires", types.StringValue("2030-01-02T03:04:05Z"), nil, types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := APIKeyResourceModel{
+				Expires: tt.expires,
+				Scopes:  types.ListNull(types.StringType),
+				Volumes: types.ListNull(types.Int64Type),
+			}
+			k := *key
+			k.Expires = tt.apiExpires
+			if diags := m.fromAPI(ctx, &k); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !m.Expires.Equal(tt.wantExpires) {
+				t.Errorf("expires = %s, want %s", m.Expires, tt.wantExpires)
+			}
+		})
+	}
+
+	m := APIKeyResourceModel{
+		Scopes:  types.ListValueMust(types.StringType, nil),
+		Volumes: types.ListNull(types.Int64Type),
+	}
+	if diags := m.fromAPI(ctx, key); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Scopes.IsNull() || len(m.Scopes.Elements()) != 0 {
+		t.Errorf("scopes = %s, want an empty list", m.Scopes)
+	}
+	if !m.Volumes.IsNull() || !m.Description.IsNull() {
+		t.Errorf("volumes = %s, description = %s, want null", m.Volumes, m.Description)
+	}
+}
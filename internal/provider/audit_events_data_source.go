@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &AuditEventsDataSource{}
+
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &AuditEventsDataSource{}
+}
+
+// AuditEventsDataSource defines the data source implementation.
+type AuditEventsDataSource struct {
+	client *juicefs.Client
+}
+
+type AuditEventDataSourceModel struct {
+	Id       types.Int64  `tfsdk:"id"`
+	Time     types.String `tfsdk:"time"`
+	Actor    types.String `tfsdk:"actor"`
+	Action   types.String `tfsdk:"action"`
+	Volume   types.Int64  `tfsdk:"volume"`
+	Target   types.String `tfsdk:"target"`
+	SourceIP types.String `tfsdk:"source_ip"`
+	Details  types.String `tfsdk:"details"`
+}
+
+func (AuditEventDataSourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Event identifier",
+				Computed:            true,
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Time of the event in RFC 3339 format",
+				Computed:            true,
+			},
+			"actor": schema.StringAttribute{
+				MarkdownDescription: "Email of the member or name of the API key that performed the action",
+				Computed:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action performed, e.g. `export.update`",
+				Computed:            true,
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume the action applies to, if any",
+				Computed:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Object the action applies to",
+				Computed:            true,
+			},
+			"source_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address the request came from",
+				Computed:            true,
+			},
+			"details": schema.StringAttribute{
+				MarkdownDescription: "Details of the change as a JSON document",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (AuditEventDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":        types.Int64Type,
+		"time":      types.StringType,
+		"actor":     types.StringType,
+		"action":    types.StringType,
+		"volume":    types.Int64Type,
+		"target":    types.StringType,
+		"source_ip": types.StringType,
+		"details":   types.StringType,
+	}
+}
+
+// AuditEventsDataSourceModel describes the data source data model.
+type AuditEventsDataSourceModel struct {
+	Start  types.String `tfsdk:"start"`
+	End    types.String `tfsdk:"end"`
+	Volume types.Int64  `tfsdk:"volume"`
+	Actor  types.String `tfsdk:"actor"`
+	Action types.String `tfsdk:"action"`
+	Events types.List   `tfsdk:"events"`
+}
+
+func (d *AuditEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+func (d *AuditEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Audit events of the organization, oldest first",
+		Attributes: map[string]schema.Attribute{
+			"start": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only return events at or after this time, in RFC 3339 format",
+			},
+			"end": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only return events before this time, in RFC 3339 format",
+			},
+			"volume": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only return events of this volume",
+			},
+			"actor": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only return events performed by this member email or API key",
+			},
+			"action": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only return events of this action, e.g. `export.update`",
+			},
+			"events": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Matching audit events",
+				NestedObject:        AuditEventDataSourceModel{}.schema(),
+			},
+		},
+	}
+}
+
+func (d *AuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditEventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := juicefs.AuditLogFilter{
+		Volume: data.Volume.ValueInt64(),
+		Actor:  data.Actor.ValueString(),
+		Action: data.Action.ValueString(),
+	}
+	if !data.Start.IsNull() {
+		start, err := time.Parse(time.RFC3339, data.Start.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start"), "Invalid Start Time", fmt.Sprintf("Unable to parse start %q: %s", data.Start.ValueString(), err))
+			return
+		}
+		filter.Start = start
+	}
+	if !data.End.IsNull() {
+		end, err := time.Parse(time.RFC3339, data.End.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid End Time", fmt.Sprintf("Unable to parse end %q: %s", data.End.ValueString(), err))
+			return
+		}
+		filter.End = end
+	}
+
+	events, err := d.client.GetAuditLogs(filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit logs, got error: %s", err))
+		return
+	}
+
+	items := make([]AuditEventDataSourceModel, 0, len(events))
+	for _, event := range events {
+		item := AuditEventDataSourceModel{
+			Id:       types.Int64Value(event.Id),
+			Time:     types.StringValue(event.Time.Format(time.RFC3339)),
+			Actor:    types.StringValue(event.Actor),
+			Action:   types.StringValue(event.Action),
+			Volume:   types.Int64PointerValue(event.Volume),
+			Target:   types.StringValue(event.Target),
+			SourceIP: types.StringValue(event.SourceIP),
+			Details:  types.StringNull(),
+		}
+		if len(event.Details) > 0 {
+			item.Details = types.StringValue(string(event.Details))
+		}
+		items = append(items, item)
+	}
+	eventList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: AuditEventDataSourceModel{}.attrType(),
+	}, items)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Events = eventList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
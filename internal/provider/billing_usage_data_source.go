@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &BillingUsageDataSource{}
+
+func NewBillingUsageDataSource() datasource.DataSource {
+	return &BillingUsageDataSource{}
+}
+
+// BillingUsageDataSource defines the data source implementation.
+type BillingUsageDataSource struct {
+	client *juicefs.Client
+}
+
+// billingItemAttributes returns the usage and cost attributes shared by the
+// volume and region items.
+func billingItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"metadata_fee": schema.Float64Attribute{
+			MarkdownDescription: "Metadata service fee",
+			Computed:            true,
+		},
+		"storage_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Average bytes stored over the period",
+			Computed:            true,
+		},
+		"requests": schema.Int64Attribute{
+			MarkdownDescription: "Number of metadata requests",
+			Computed:            true,
+		},
+		"total": schema.Float64Attribute{
+			MarkdownDescription: "Total fee",
+			Computed:            true,
+		},
+	}
+}
+
+type VolumeBillingDataSourceModel struct {
+	Volume       types.Int64   `tfsdk:"volume"`
+	Name         types.String  `tfsdk:"name"`
+	Region       types.Int64   `tfsdk:"region"`
+	MetadataFee  types.Float64 `tfsdk:"metadata_fee"`
+	StorageBytes types.Int64   `tfsdk:"storage_bytes"`
+	Requests     types.Int64   `tfsdk:"requests"`
+	Total        types.Float64 `tfsdk:"total"`
+}
+
+func (VolumeBillingDataSourceModel) schema() schema.NestedAttributeObject {
+	attributes := billingItemAttributes()
+	attributes["volume"] = schema.Int64Attribute{
+		MarkdownDescription: "Volume identifier",
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the volume",
+		Computed:            true,
+	}
+	attributes["region"] = schema.Int64Attribute{
+		MarkdownDescription: "Region of the volume",
+		Computed:            true,
+	}
+	return schema.NestedAttributeObject{Attributes: attributes}
+}
+
+func (VolumeBillingDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"volume":        types.Int64Type,
+		"name":          types.StringType,
+		"region":        types.Int64Type,
+		"metadata_fee":  types.Float64Type,
+		"storage_bytes": types.Int64Type,
+		"requests":      types.Int64Type,
+		"total":         types.Float64Type,
+	}
+}
+
+type RegionBillingDataSourceModel struct {
+	Region       types.Int64   `tfsdk:"region"`
+	MetadataFee  types.Float64 `tfsdk:"metadata_fee"`
+	StorageBytes types.Int64   `tfsdk:"storage_bytes"`
+	Requests     types.Int64   `tfsdk:"requests"`
+	Total        types.Float64 `tfsdk:"total"`
+}
+
+func (RegionBillingDataSourceModel) schema() schema.NestedAttributeObject {
+	attributes := billingItemAttributes()
+	attributes["region"] = schema.Int64Attribute{
+		MarkdownDescription: "Region identifier",
+		Computed:            true,
+	}
+	return schema.NestedAttributeObject{Attributes: attributes}
+}
+
+func (RegionBillingDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region":        types.Int64Type,
+		"metadata_fee":  types.Float64Type,
+		"storage_bytes": types.Int64Type,
+		"requests":      types.Int64Type,
+		"total":         types.Float64Type,
+	}
+}
+
+// BillingUsageDataSourceModel describes the data source data model.
+type BillingUsageDataSourceModel struct {
+	Start    types.String  `tfsdk:"start"`
+	End      types.String  `tfsdk:"end"`
+	Currency types.String  `tfsdk:"currency"`
+	Total    types.Float64 `tfsdk:"total"`
+	Volumes  types.List    `tfsdk:"volumes"`
+	Regions  types.List    `tfsdk:"regions"`
+}
+
+// period parses the start and end dates of the billing period.
+func (m BillingUsageDataSourceModel) period() (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	start, err := time.Parse(juicefs.BillingDateLayout, m.Start.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("start"), "Invalid Start Date", fmt.Sprintf("Unable to parse start %q: %s", m.Start.ValueString(), err))
+		return start, start, diags
+	}
+	end, err := time.Parse(juicefs.BillingDateLayout, m.End.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("end"), "Invalid End Date", fmt.Sprintf("Unable to parse end %q: %s", m.End.ValueString(), err))
+		return start, end, diags
+	}
+	if end.Before(start) {
+		diags.AddAttributeError(path.Root("end"), "Invalid Date Range", "end must not be before start.")
+	}
+	return start, end, diags
+}
+
+func (d *BillingUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billing_usage"
+}
+
+func (d *BillingUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Usage and fees of the organization per volume and per region over a date range",
+		Attributes: map[string]schema.Attribute{
+			"start": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "First day of the period in `YYYY-MM-DD` format",
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Last day of the period in `YYYY-MM-DD` format, included",
+			},
+			"currency": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Currency of the fees",
+			},
+			"total": schema.Float64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Total fee over the period",
+			},
+			"volumes": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Usage and fees per volume",
+				NestedObject:        VolumeBillingDataSourceModel{}.schema(),
+			},
+			"regions": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Usage and fees per region",
+				NestedObject:        RegionBillingDataSourceModel{}.schema(),
+			},
+		},
+	}
+}
+
+func (d *BillingUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BillingUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BillingUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, end, diags := data.period()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := d.client.GetBilling(start, end)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read billing, got error: %s", err))
+		return
+	}
+
+	volumes := make([]VolumeBillingDataSourceModel, 0, len(report.Volumes))
+	for _, item := range report.Volumes {
+		volumes = append(volumes, VolumeBillingDataSourceModel{
+			Volume:       types.Int64Value(item.Volume),
+			Name:         types.StringValue(item.Name),
+			Region:       types.Int64Value(item.Region),
+			MetadataFee:  types.Float64Value(item.MetadataFee),
+			StorageBytes: types.Int64Value(item.StorageBytes),
+			Requests:     types.Int64Value(item.Requests),
+			Total:        types.Float64Value(item.Total),
+		})
+	}
+	regions := make([]RegionBillingDataSourceModel, 0, len(report.Regions))
+	for _, item := range report.Regions {
+		regions = append(regions, RegionBillingDataSourceModel{
+			Region:       types.Int64Value(item.Region),
+			MetadataFee:  types.Float64Value(item.MetadataFee),
+			StorageBytes: types.Int64Value(item.StorageBytes),
+			Requests:     types.Int64Value(item.Requests),
+			Total:        types.Float64Value(item.Total),
+		})
+	}
+	volumeList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: VolumeBillingDataSourceModel{}.attrType(),
+	}, volumes)
+	resp.Diagnostics.Append(diag...)
+	regionList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: RegionBillingDataSourceModel{}.attrType(),
+	}, regions)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Currency = types.StringValue(report.Currency)
+	data.Total = types.Float64Value(report.Total)
+	data.Volumes = volumeList
+	data.Regions = regionList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBillingUsageDataSourceModelPeriod(t *testing.T) {
+	tests := []struct {
+		start, end string
+		wantErr    bool
+	}{
+		{"2024-01-01", "2024-01-31", false},
+		{"2024-01-31", "2024-01-31", false},
+		{"2024-02-01", "2024-01-31", true},
+		{"2024-1-1", "2024-01-31", true},
+		{"2024-01-01", "2024-01-31T00:00:00Z", true},
+	}
+	for _, tt := range tests {
+		m := BillingUsageDataSourceModel{Start: types.StringValue(tt.start), End: types.StringValue(tt.end)}
+		start, end, diags := m.period()
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("period(%s, %s) errors = %v, wantErr %t", tt.start, tt.end, diags, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got := start.Format(time.DateOnly); got != tt.start {
+			t.Errorf("period(%s, %s) start = %s", tt.start, tt.end, got)
+		}
+		if got := end.Format(time.DateOnly); got != tt.end {
+			t.Errorf("period(%s, %s) end = %s", tt.start, tt.end, got)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CacheGroupResource{}
+var _ resource.ResourceWithImportState = &CacheGroupResource{}
+
+func NewCacheGroupResource() resource.Resource {
+	return &CacheGroupResource{}
+}
+
+// CacheGroupResource defines the resource implementation.
+type CacheGroupResource struct {
+	client *juicefs.Client
+}
+
+// CacheGroupResourceModel describes the resource data model.
+type CacheGroupResourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Volume      types.Int64  `tfsdk:"volume"`
+	Name        types.String `tfsdk:"name"`
+	Hosts       types.List   `tfsdk:"hosts"`
+	CacheSize   types.Int64  `tfsdk:"cache_size"`
+	WarmupPaths types.List   `tfsdk:"warmup_paths"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (m CacheGroupResourceModel) toAPI(ctx context.Context) (juicefs.CacheGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := juicefs.CacheGroupRequest{
+		Name:        m.Name.ValueString(),
+		CacheSize:   m.CacheSize.ValueInt64(),
+		Hosts:       []string{},
+		WarmupPaths: []string{},
+	}
+	diags.Append(m.Hosts.ElementsAs(ctx, &req.Hosts, false)...)
+	if !m.WarmupPaths.IsNull() {
+		diags.Append(m.WarmupPaths.ElementsAs(ctx, &req.WarmupPaths, false)...)
+	}
+	return req, diags
+}
+
+func (m *CacheGroupResourceModel) fromAPI(ctx context.Context, group *juicefs.CacheGroup) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.Id = types.Int64Value(group.Id)
+	m.Volume = types.Int64Value(group.Volume)
+	m.Name = types.StringValue(group.Name)
+	m.CacheSize = types.Int64Value(group.CacheSize)
+	m.Status = types.StringValue(group.Status)
+	hosts := group.Hosts
+	if hosts == nil {
+		hosts = []string{}
+	}
+	m.Hosts, d = types.ListValueFrom(ctx, types.StringType, hosts)
+	diags.Append(d...)
+	// Keep warmup_paths null rather than empty when it is not configured.
+	if len(group.WarmupPaths) > 0 || !m.WarmupPaths.IsNull() {
+		warmupPaths := group.WarmupPaths
+		if warmupPaths == nil {
+			warmupPaths = []string{}
+		}
+		m.WarmupPaths, d = types.ListValueFrom(ctx, types.StringType, warmupPaths)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+func (r *CacheGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache_group"
+}
+
+func (r *CacheGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Distributed cache group shared by the clients mounting a volume",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Cache group identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cache group, passed to `juicefs mount --cache-group`",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Hosts that are members of the cache group",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"cache_size": schema.Int64Attribute{
+				MarkdownDescription: "Cache size of each member in MiB",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"warmup_paths": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Directories of the volume kept warm in the cache group",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the cache group",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CacheGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitForReady polls the cache group until it is ready and returns its final
+// state.
+func (r *CacheGroupResource) waitForReady(ctx context.Context, volumeID int64, groupID int64) (*juicefs.CacheGroup, error) {
+	var group *juicefs.CacheGroup
+	err := waitUntil(ctx, func() (bool, error) {
+		var err error
+		group, err = r.client.GetCacheGroup(volumeID, groupID)
+		if err != nil {
+			return false, err
+		}
+		if group.Status == juicefs.CacheGroupStatusFailed {
+			return false, fmt.Errorf("cache group %d failed", groupID)
+		}
+		return group.Status == juicefs.CacheGroupStatusReady, nil
+	})
+	return group, err
+}
+
+func (r *CacheGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CacheGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	group, err := r.client.CreateCacheGroup(volumeID, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cache group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("cache group created: name=%s ID=%d", group.Name, group.Id))
+
+	group, err = r.waitForReady(ctx, volumeID, group.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check cache group status, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CacheGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetCacheGroup(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cache group, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CacheGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	group, err := r.client.UpdateCacheGroup(volumeID, data.Id.ValueInt64(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cache group, got error: %s", err))
+		return
+	}
+	group, err = r.waitForReady(ctx, volumeID, group.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check cache group status, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CacheGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCacheGroup(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cache group, got error: %s", err))
+		return
+	}
+}
+
+func (r *CacheGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, groupID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/cache_group_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupID)...)
+}
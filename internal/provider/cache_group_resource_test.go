@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCacheGroupResourceModelFromAPI(t *testing.T) {
+	ctx := context.Background()
+
+	m := CacheGroupResourceModel{
+		WarmupPaths: types.ListValueMust(types.StringType, nil),
+	}
+	diags := m.fromAPI(ctx, &juicefs.CacheGroup{Id: 1, Volume: 2, Name: "web"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Hosts.IsNull() || len(m.Hosts.Elements()) != 0 {
+		t.Errorf("hosts = %s, want an empty list", m.Hosts)
+	}
+	if m.WarmupPaths.IsNull() || len(m.WarmupPaths.Elements()) != 0 {
+		t.Errorf("warmup_paths = %s, want an empty list", m.WarmupPaths)
+	}
+
+	m = CacheGroupResourceModel{WarmupPaths: types.ListNull(types.StringType)}
+	diags = m.fromAPI(ctx, &juicefs.CacheGroup{Id: 1, Volume: 2, Name: "web"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !m.WarmupPaths.IsNull() {
+		t.Errorf("warmup_paths = %s, want null when not configured", m.WarmupPaths)
+	}
+}
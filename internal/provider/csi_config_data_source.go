@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &CSIConfigDataSource{}
+
+func NewCSIConfigDataSource() datasource.DataSource {
+	return &CSIConfigDataSource{}
+}
+
+// CSIConfigDataSource defines the data source implementation.
+type CSIConfigDataSource struct {
+	client *juicefs.Client
+}
+
+// CSIConfigDataSourceModel describes the data source data model.
+type CSIConfigDataSourceModel struct {
+	Volume               types.Int64  `tfsdk:"volume"`
+	Token                types.String `tfsdk:"token"`
+	AccessKey            types.String `tfsdk:"access_key"`
+	SecretKey            types.String `tfsdk:"secret_key"`
+	Bucket               types.String `tfsdk:"bucket"`
+	Envs                 types.Map    `tfsdk:"envs"`
+	MountOptions         types.List   `tfsdk:"mount_options"`
+	SecretName           types.String `tfsdk:"secret_name"`
+	SecretNamespace      types.String `tfsdk:"secret_namespace"`
+	StorageClassName     types.String `tfsdk:"storage_class_name"`
+	PersistentVolumeName types.String `tfsdk:"persistent_volume_name"`
+	Capacity             types.String `tfsdk:"capacity"`
+	SecretData           types.Map    `tfsdk:"secret_data"`
+	StorageClass         types.String `tfsdk:"storage_class"`
+	PersistentVolume     types.String `tfsdk:"persistent_volume"`
+}
+
+func (d *CSIConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_csi_config"
+}
+
+func (d *CSIConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders the Kubernetes Secret, StorageClass and PersistentVolume used by the JuiceFS CSI driver for a volume",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"token": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Access rule token used to mount, default to the token of the first access rule of the volume",
+			},
+			"access_key": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+				MarkdownDescription: "Object storage access key",
+			},
+			"secret_key": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+				MarkdownDescription: "Object storage secret key",
+			},
+			"bucket": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Bucket endpoint, only needed when it differs from the one of the volume",
+			},
+			"envs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Environment variables passed to the mount pod",
+			},
+			"mount_options": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Mount options of the StorageClass and PersistentVolume, e.g. `cache-size=102400`",
+			},
+			"secret_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the Kubernetes Secret, default to `juicefs-<volume name>`",
+			},
+			"secret_namespace": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Namespace of the Kubernetes Secret, default to `default`",
+			},
+			"storage_class_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the StorageClass, default to `juicefs-<volume name>`",
+			},
+			"persistent_volume_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the PersistentVolume, default to `juicefs-<volume name>`",
+			},
+			"capacity": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Capacity of the PersistentVolume, default to `10Pi`",
+			},
+			"secret_data": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Data of the Kubernetes Secret, suitable for the `data` argument of `kubernetes_secret`",
+			},
+			"storage_class": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "JSON encoded StorageClass manifest, suitable for `jsondecode()` into `kubernetes_manifest`",
+			},
+			"persistent_volume": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "JSON encoded PersistentVolume manifest, suitable for `jsondecode()` into `kubernetes_manifest`",
+			},
+		},
+	}
+}
+
+func (d *CSIConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CSIConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CSIConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err := d.client.GetVolume(data.Volume.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
+		return
+	}
+
+	if data.Token.IsNull() {
+		if len(volume.AccessRules) == 0 {
+			resp.Diagnostics.AddError("Missing Token", fmt.Sprintf("Volume %s has no access rules, please set token explicitly", volume.Name))
+			return
+		}
+		data.Token = types.StringValue(volume.AccessRules[0].Token)
+	}
+	defaultName := "juicefs-" + volume.Name
+	if data.SecretName.IsNull() {
+		data.SecretName = types.StringValue(defaultName)
+	}
+	if data.SecretNamespace.IsNull() {
+		data.SecretNamespace = types.StringValue("default")
+	}
+	if data.StorageClassName.IsNull() {
+		data.StorageClassName = types.StringValue(defaultName)
+	}
+	if data.PersistentVolumeName.IsNull() {
+		data.PersistentVolumeName = types.StringValue(defaultName)
+	}
+	if data.Capacity.IsNull() {
+		data.Capacity = types.StringValue("10Pi")
+	}
+
+	csi := juicefs.CSIConfig{
+		Name:            volume.Name,
+		Token:           data.Token.ValueString(),
+		AccessKey:       data.AccessKey.ValueString(),
+		SecretKey:       data.SecretKey.ValueString(),
+		Bucket:          data.Bucket.ValueString(),
+		SecretName:      data.SecretName.ValueString(),
+		SecretNamespace: data.SecretNamespace.ValueString(),
+	}
+	if !data.Envs.IsNull() {
+		resp.Diagnostics.Append(data.Envs.ElementsAs(ctx, &csi.Envs, false)...)
+	}
+	if !data.MountOptions.IsNull() {
+		resp.Diagnostics.Append(data.MountOptions.ElementsAs(ctx, &csi.MountOptions, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretData, err := csi.SecretData()
+	if err != nil {
+		resp.Diagnostics.AddError("Render Error", fmt.Sprintf("Unable to render secret data, got error: %s", err))
+		return
+	}
+	secretDataValue, diag := types.MapValueFrom(ctx, types.StringType, secretData)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	storageClass, err := json.Marshal(csi.StorageClass(data.StorageClassName.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Render Error", fmt.Sprintf("Unable to render storage class, got error: %s", err))
+		return
+	}
+	persistentVolume, err := json.Marshal(csi.PersistentVolume(data.PersistentVolumeName.ValueString(), data.Capacity.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Render Error", fmt.Sprintf("Unable to render persistent volume, got error: %s", err))
+		return
+	}
+
+	data.SecretData = secretDataValue
+	data.StorageClass = types.StringValue(string(storageClass))
+	data.PersistentVolume = types.StringValue(string(persistentVolume))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
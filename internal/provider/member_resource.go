@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MemberResource{}
+var _ resource.ResourceWithImportState = &MemberResource{}
+
+func NewMemberResource() resource.Resource {
+	return &MemberResource{}
+}
+
+// MemberResource defines the resource implementation.
+type MemberResource struct {
+	client *juicefs.Client
+}
+
+// MemberResourceModel describes the resource data model.
+type MemberResourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (m *MemberResourceModel) fromAPI(member *juicefs.Member) {
+	m.Id = types.Int64Value(member.Id)
+	m.Email = types.StringValue(member.Email)
+	m.Role = types.StringValue(member.Role)
+	m.Status = types.StringValue(member.Status)
+}
+
+func (r *MemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
+
+func (r *MemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Member of the organization, invited by email",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Member identifier, as used by the `owner` attribute of volumes and regions",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email the invitation is sent to",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member in the organization",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the invitation is pending or accepted",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.InviteMember(juicefs.MemberRequest{
+		Email: data.Email.ValueString(),
+		Role:  data.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("member invited: email=%s ID=%d", member.Email, member.Id))
+
+	data.fromAPI(member)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetMember(data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read member, got error: %s", err))
+		return
+	}
+	data.fromAPI(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.UpdateMember(data.Id.ValueInt64(), juicefs.MemberRequest{
+		Role: data.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member, got error: %s", err))
+		return
+	}
+	data.fromAPI(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMember(data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete member, got error: %s", err))
+		return
+	}
+}
+
+func (r *MemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected a numeric member identifier. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &MembersDataSource{}
+
+func NewMembersDataSource() datasource.DataSource {
+	return &MembersDataSource{}
+}
+
+// MembersDataSource defines the data source implementation.
+type MembersDataSource struct {
+	client *juicefs.Client
+}
+
+type MemberDataSourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (MemberDataSourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Member identifier",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the member",
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member in the organization",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the invitation is pending or accepted",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (MemberDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.Int64Type,
+		"email":  types.StringType,
+		"role":   types.StringType,
+		"status": types.StringType,
+	}
+}
+
+// MembersDataSourceModel describes the data source data model.
+type MembersDataSourceModel struct {
+	Members types.List `tfsdk:"members"`
+	Emails  types.Map  `tfsdk:"emails"`
+}
+
+func (d *MembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_members"
+}
+
+func (d *MembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Members of the organization",
+		Attributes: map[string]schema.Attribute{
+			"members": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "All members of the organization",
+				NestedObject:        MemberDataSourceModel{}.schema(),
+			},
+			"emails": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Member emails keyed by member identifier, to resolve the `owner` of volumes and regions",
+			},
+		},
+	}
+}
+
+func (d *MembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.GetMembers()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read members, got error: %s", err))
+		return
+	}
+
+	items := make([]MemberDataSourceModel, 0, len(members))
+	emails := make(map[string]string, len(members))
+	for _, member := range members {
+		items = append(items, MemberDataSourceModel{
+			Id:     types.Int64Value(member.Id),
+			Email:  types.StringValue(member.Email),
+			Role:   types.StringValue(member.Role),
+			Status: types.StringValue(member.Status),
+		})
+		emails[strconv.FormatInt(member.Id, 10)] = member.Email
+	}
+	memberList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: MemberDataSourceModel{}.attrType(),
+	}, items)
+	resp.Diagnostics.Append(diag...)
+	emailMap, diag := types.MapValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberList
+	data.Emails = emailMap
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
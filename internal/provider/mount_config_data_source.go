@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &MountConfigDataSource{}
+
+func NewMountConfigDataSource() datasource.DataSource {
+	return &MountConfigDataSource{}
+}
+
+// MountConfigDataSource defines the data source implementation.
+type MountConfigDataSource struct {
+	client *juicefs.Client
+}
+
+// MountConfigDataSourceModel describes the data source data model.
+type MountConfigDataSourceModel struct {
+	Volume          types.Int64  `tfsdk:"volume"`
+	Token           types.String `tfsdk:"token"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretKey       types.String `tfsdk:"secret_key"`
+	Bucket          types.String `tfsdk:"bucket"`
+	MountPoint      types.String `tfsdk:"mount_point"`
+	CacheDir        types.String `tfsdk:"cache_dir"`
+	CacheSize       types.Int64  `tfsdk:"cache_size"`
+	CacheGroup      types.String `tfsdk:"cache_group"`
+	Writeback       types.Bool   `tfsdk:"writeback"`
+	Name            types.String `tfsdk:"name"`
+	AuthCommand     types.String `tfsdk:"auth_command"`
+	MountCommand    types.String `tfsdk:"mount_command"`
+	SystemdUnitName types.String `tfsdk:"systemd_unit_name"`
+	SystemdUnit     types.String `tfsdk:"systemd_unit"`
+	FstabLine       types.String `tfsdk:"fstab_line"`
+}
+
+func (d *MountConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount_config"
+}
+
+func (d *MountConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders the commands and files needed to mount a volume",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"token": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Access rule token used to mount, default to the token of the first access rule of the volume",
+			},
+			"access_key": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+				MarkdownDescription: "Object storage access key",
+			},
+			"secret_key": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+				MarkdownDescription: "Object storage secret key",
+			},
+			"bucket": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Bucket endpoint, only needed when it differs from the one of the volume",
+			},
+			"mount_point": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Directory to mount the volume on",
+			},
+			"cache_dir": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Local cache directory",
+			},
+			"cache_size": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Local cache size in MiB",
+			},
+			"cache_group": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Name of the cache group to join, e.g. the `name` of a `juicefscloud_cache_group`",
+			},
+			"writeback": schema.BoolAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Upload objects in background",
+			},
+			"name": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Name of the volume",
+			},
+			"auth_command": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "`juicefs auth` command",
+			},
+			"mount_command": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "`juicefs mount` command",
+			},
+			"systemd_unit_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "File name of the systemd mount unit",
+			},
+			"systemd_unit": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Content of the systemd mount unit",
+			},
+			"fstab_line": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "`/etc/fstab` entry",
+			},
+		},
+	}
+}
+
+func (d *MountConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MountConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MountConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err := d.client.GetVolume(data.Volume.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
+		return
+	}
+
+	if data.Token.IsNull() {
+		if len(volume.AccessRules) == 0 {
+			resp.Diagnostics.AddError("Missing Token", fmt.Sprintf("Volume %s has no access rules, please set token explicitly", volume.Name))
+			return
+		}
+		data.Token = types.StringValue(volume.AccessRules[0].Token)
+	}
+
+	mount := juicefs.MountConfig{
+		Name:       volume.Name,
+		Token:      data.Token.ValueString(),
+		AccessKey:  data.AccessKey.ValueString(),
+		SecretKey:  data.SecretKey.ValueString(),
+		Bucket:     data.Bucket.ValueString(),
+		MountPoint: data.MountPoint.ValueString(),
+		CacheDir:   data.CacheDir.ValueString(),
+		CacheSize:  data.CacheSize.ValueInt64(),
+		CacheGroup: data.CacheGroup.ValueString(),
+		Writeback:  data.Writeback.ValueBool(),
+	}
+	if err := mount.Validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid Mount Config", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(volume.Name)
+	data.AuthCommand = types.StringValue(mount.AuthCommand())
+	data.MountCommand = types.StringValue(mount.MountCommand())
+	data.SystemdUnitName = types.StringValue(mount.SystemdUnitName())
+	data.SystemdUnit = types.StringValue(mount.SystemdUnit())
+	data.FstabLine = types.StringValue(mount.FstabLine())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
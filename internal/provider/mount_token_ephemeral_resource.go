@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResourceWithConfigure = &MountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &MountTokenEphemeralResource{}
+
+// mountTokenPrivateKey is the private data key the issued token is kept under
+// so that Close can revoke it.
+const mountTokenPrivateKey = "mount_token"
+
+func NewMountTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &MountTokenEphemeralResource{}
+}
+
+// MountTokenEphemeralResource defines the ephemeral resource implementation.
+type MountTokenEphemeralResource struct {
+	client *juicefs.Client
+}
+
+// MountTokenEphemeralResourceModel describes the ephemeral resource data model.
+type MountTokenEphemeralResourceModel struct {
+	Volume  types.Int64  `tfsdk:"volume"`
+	IpRange types.String `tfsdk:"ip_range"`
+	TTL     types.Int64  `tfsdk:"ttl"`
+	Token   types.String `tfsdk:"token"`
+	Expires types.String `tfsdk:"expires"`
+}
+
+type mountTokenPrivateData struct {
+	Volume int64 `json:"volume"`
+	Token  int64 `json:"token"`
+}
+
+func (r *MountTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount_token"
+}
+
+func (r *MountTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mount token of a volume that is never persisted to plan or state. " +
+			"With `ttl` set, a temporary token is issued and revoked once Terraform is done with it; " +
+			"otherwise the token of an existing access rule is returned.",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"ip_range": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "IP range of the access rule the token belongs to, default to the first access rule",
+			},
+			"ttl": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Lifetime in seconds of a temporary token to issue",
+			},
+			"token": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token for mounting the volume",
+			},
+			"expires": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Expiration time of a temporary token, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (r *MountTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MountTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data MountTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	if !data.TTL.IsNull() {
+		token, err := r.client.CreateMountToken(volumeID, juicefs.CreateMountTokenRequest{
+			IpRange: data.IpRange.ValueString(),
+			TTL:     data.TTL.ValueInt64(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mount token, got error: %s", err))
+			return
+		}
+		private, err := json.Marshal(mountTokenPrivateData{Volume: volumeID, Token: token.Id})
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, mountTokenPrivateKey, private)...)
+		data.Token = types.StringValue(token.Token)
+		data.Expires = types.StringValue(token.Expires.Format(time.RFC3339))
+	} else {
+		volume, err := r.client.GetVolume(volumeID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
+			return
+		}
+		found := false
+		for _, accessRule := range volume.AccessRules {
+			if data.IpRange.IsNull() || accessRule.IpRange == data.IpRange.ValueString() {
+				found = true
+				data.Token = types.StringValue(accessRule.Token)
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("Access Rule Not Found", fmt.Sprintf("Unable to find an access rule of volume %s matching ip range %q", volume.Name, data.IpRange.ValueString()))
+			return
+		}
+		data.Expires = types.StringNull()
+	}
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *MountTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, mountTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		// Tokens of existing access rules are not ours to revoke.
+		return
+	}
+
+	var data mountTokenPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data, got error: %s", err))
+		return
+	}
+	if err := r.client.RevokeMountToken(data.Volume, data.Token); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke mount token, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationChannelResource{}
+var _ resource.ResourceWithImportState = &NotificationChannelResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+// NotificationChannelResource defines the resource implementation.
+type NotificationChannelResource struct {
+	client *juicefs.Client
+}
+
+// NotificationChannelResourceModel describes the resource data model.
+type NotificationChannelResourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Emails types.List   `tfsdk:"emails"`
+	URL    types.String `tfsdk:"url"`
+}
+
+func (m NotificationChannelResourceModel) toAPI(ctx context.Context) (juicefs.NotificationChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := juicefs.NotificationChannelRequest{
+		Name: m.Name.ValueString(),
+		Type: m.Type.ValueString(),
+		URL:  m.URL.ValueString(),
+	}
+	if !m.Emails.IsNull() {
+		diags.Append(m.Emails.ElementsAs(ctx, &req.Emails, false)...)
+	}
+	return req, diags
+}
+
+func (m *NotificationChannelResourceModel) fromAPI(ctx context.Context, channel *juicefs.NotificationChannel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Id = types.Int64Value(channel.Id)
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(channel.Type)
+	if len(channel.Emails) > 0 || !m.Emails.IsNull() {
+		emails := channel.Emails
+		if emails == nil {
+			emails = []string{}
+		}
+		m.Emails, diags = types.ListValueFrom(ctx, types.StringType, emails)
+	}
+	if channel.URL != "" || !m.URL.IsNull() {
+		m.URL = types.StringValue(channel.URL)
+	}
+	return diags
+}
+
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Destination alert notifications are sent to",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Notification channel identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the notification channel",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the notification channel, one of `email`, `webhook` or `slack`",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Recipients of an `email` channel",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL a `webhook` channel posts to, or Slack-compatible incoming webhook URL of a `slack` channel",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+	channelType := data.Type.ValueString()
+	if !slices.Contains(juicefs.NotificationChannelTypes, channelType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Notification Channel Type",
+			fmt.Sprintf("Type must be one of %s, got: %q.", strings.Join(juicefs.NotificationChannelTypes, ", "), channelType),
+		)
+		return
+	}
+	if channelType == juicefs.NotificationChannelEmail {
+		if data.Emails.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("emails"), "Missing Email Recipients", "emails must be set for an email channel.")
+		}
+		if !data.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Unexpected URL", "url can only be set for webhook and slack channels.")
+		}
+	} else {
+		if data.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Missing Webhook URL", fmt.Sprintf("url must be set for a %s channel.", channelType))
+		}
+		if !data.Emails.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("emails"), "Unexpected Email Recipients", "emails can only be set for email channels.")
+		}
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.CreateNotificationChannel(apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("notification channel created: name=%s ID=%d", channel.Name, channel.Id))
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, channel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetNotificationChannel(data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification channel, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, channel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateNotificationChannel(data.Id.ValueInt64(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, channel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationChannel(data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected a numeric notification channel identifier. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNotificationChannelResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &NotificationChannelResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	emails := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "ops@example.com"),
+	})
+	noEmails := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+	url := tftypes.NewValue(tftypes.String, "https://hooks.example.com/alert")
+	noURL := tftypes.NewValue(tftypes.String, nil)
+
+	tests := []struct {
+		name        string
+		channelType tftypes.Value
+		emails      tftypes.Value
+		url         tftypes.Value
+		wantErrors  int
+	}{
+		{"email", tftypes.NewValue(tftypes.String, "email"), emails, noURL, 0},
+		{"email without recipients", tftypes.NewValue(tftypes.String, "email"), noEmails, noURL, 1},
+		{"email with url", tftypes.NewValue(tftypes.String, "email"), emails, url, 1},
+		{"webhook", tftypes.NewValue(tftypes.String, "webhook"), noEmails, url, 0},
+		{"webhook without url", tftypes.NewValue(tftypes.String, "webhook"), noEmails, noURL, 1},
+		{"slack with emails", tftypes.NewValue(tftypes.String, "slack"), emails, url, 1},
+		{"unknown type", tftypes.NewValue(tftypes.String, "pager"), noEmails, url, 1},
+		{"type not known yet", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), emails, url, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.Number, nil),
+					"name":   tftypes.NewValue(tftypes.String, "ops"),
+					"type":   tt.channelType,
+					"emails": tt.emails,
+					"url":    tt.url,
+				}),
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("ValidateConfig() errors = %d, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNotificationChannelResourceModelFromAPI(t *testing.T) {
+	ctx := context.Background()
+
+	m := NotificationChannelResourceModel{Emails: types.ListValueMust(types.StringType, nil)}
+	diags := m.fromAPI(ctx, &juicefs.NotificationChannel{Id: 1, Name: "ops", Type: "email"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Emails.IsNull() || len(m.Emails.Elements()) != 0 {
+		t.Errorf("emails = %s, want an empty list", m.Emails)
+	}
+
+	m = NotificationChannelResourceModel{Emails: types.ListNull(types.StringType), URL: types.StringNull()}
+	diags = m.fromAPI(ctx, &juicefs.NotificationChannel{Id: 2, Name: "hook", Type: "webhook", URL: "https://hooks.example.com/alert"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !m.Emails.IsNull() {
+		t.Errorf("emails = %s, want null", m.Emails)
+	}
+}
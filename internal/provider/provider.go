@@ -2,11 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"terraform-provider-juicefscloud/internal/juicefs"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +20,7 @@ import (
 // Ensure juicefsCloudProvider satisfies various provider interfaces.
 var _ provider.Provider = &juicefsCloudProvider{}
 var _ provider.ProviderWithFunctions = &juicefsCloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &juicefsCloudProvider{}
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -35,9 +40,14 @@ type juicefsCloudProvider struct {
 
 // juicefsCloudProviderModel describes the provider data model.
 type juicefsCloudProviderModel struct {
-	Endpoint  types.String `tfsdk:"endpoint"`
-	AccessKey types.String `tfsdk:"access_key"`
-	SecretKey types.String `tfsdk:"secret_key"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
 }
 
 func (p *juicefsCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -63,6 +73,32 @@ func (p *juicefsCloudProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Required:            true,
 				Optional:            false,
 			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system roots, for consoles using an internal certificate authority",
+				Required:            false,
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the API server certificate, default to false. Only meant for testing",
+				Required:            false,
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to reach the API through, default to the `HTTPS_PROXY` and `NO_PROXY` environment variables",
+				Required:            false,
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate presented for mutual TLS, requires `client_key_pem`",
+				Required:            false,
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert_pem`",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
@@ -76,8 +112,41 @@ func (p *juicefsCloudProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	// Arguments set from other resources are unknown until those are
+	// applied. Defer when Terraform supports it, otherwise go on with the
+	// known arguments.
+	var unknown []string
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"endpoint", data.Endpoint},
+		{"access_key", data.AccessKey},
+		{"secret_key", data.SecretKey},
+		{"ca_cert_pem", data.CACertPEM},
+		{"insecure_skip_verify", data.InsecureSkipVerify},
+		{"proxy_url", data.ProxyURL},
+		{"client_cert_pem", data.ClientCertPEM},
+		{"client_key_pem", data.ClientKeyPEM},
+	} {
+		if attribute.value.IsUnknown() {
+			unknown = append(unknown, attribute.name)
+		}
+	}
+	if len(unknown) > 0 && req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		return
+	}
+	for _, name := range unknown {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(name),
+			"Unknown Provider Configuration",
+			fmt.Sprintf("%s depends on values not known until apply and is ignored for now. Requests made before it is known may fail.", name),
+		)
+	}
+
 	endpoint := "https://juicefs.com/api/v1"
-	if !data.Endpoint.IsNull() {
+	if !data.Endpoint.IsNull() && !data.Endpoint.IsUnknown() {
 		endpoint = data.Endpoint.ValueString()
 		log.Printf("Using configured API endpoint %s", endpoint)
 	}
@@ -85,24 +154,52 @@ func (p *juicefsCloudProvider) Configure(ctx context.Context, req provider.Confi
 	accessKey := data.AccessKey.ValueString()
 	secretKey := data.SecretKey.ValueString()
 
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Insecure TLS Configuration",
+			"The API server certificate is not verified, so requests and credentials can be intercepted. Set ca_cert_pem to trust an internal certificate authority instead.",
+		)
+	}
+	transport, err := juicefs.NewTransport(juicefs.TransportOptions{
+		CACertPEM:          data.CACertPEM.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           data.ProxyURL.ValueString(),
+		ClientCertPEM:      data.ClientCertPEM.ValueString(),
+		ClientKeyPEM:       data.ClientKeyPEM.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Transport Configuration", fmt.Sprintf("Unable to configure the API client transport: %s", err))
+		return
+	}
+
 	client := &juicefs.Client{
 		Endpoint:  endpoint,
 		AccessKey: accessKey,
 		SecretKey: secretKey,
-	}
-
-	_, err := client.GetRegions()
-	if err != nil {
-		return
+		Transport: transport,
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *juicefsCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewVolumeResource,
+		NewVolumeCloneResource,
+		NewVolumeReplicaResource,
+		NewS3GatewayResource,
+		NewMemberResource,
+		NewVolumePermissionResource,
+		NewAPIKeyResource,
+		NewCacheGroupResource,
+		NewWarmupJobResource,
+		NewSyncJobResource,
+		NewVolumeMaintenanceResource,
+		NewNotificationChannelResource,
+		NewAlertRuleResource,
 	}
 }
 
@@ -111,6 +208,21 @@ func (p *juicefsCloudProvider) DataSources(ctx context.Context) []func() datasou
 		NewCloudDataSource,
 		NewRegionDataSource,
 		NewVolumeDataSource,
+		NewVolumeUsageDataSource,
+		NewVolumeMetricsDataSource,
+		NewMountConfigDataSource,
+		NewCSIConfigDataSource,
+		NewVolumeTrashDataSource,
+		NewVolumeQuotasDataSource,
+		NewMembersDataSource,
+		NewAuditEventsDataSource,
+		NewBillingUsageDataSource,
+	}
+}
+
+func (p *juicefsCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewMountTokenEphemeralResource,
 	}
 }
 
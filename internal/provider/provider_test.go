@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -20,3 +30,98 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccJuiceFSCloudProviderFactories serve the provider under its own name,
+// for acceptance tests of juicefscloud_* resources and data sources.
+var testAccJuiceFSCloudProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"juicefscloud": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccJuiceFSCloudPreCheck ensures the API credentials used by
+// testAccProviderConfig are set.
+func testAccJuiceFSCloudPreCheck(t *testing.T) {
+	for _, name := range []string{"JUICEFS_ACCESS_KEY", "JUICEFS_SECRET_KEY"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
+// testAccProviderConfig configures the provider with the API credentials
+// checked by testAccJuiceFSCloudPreCheck.
+func testAccProviderConfig() string {
+	return fmt.Sprintf(`
+provider "juicefscloud" {
+  access_key = %q
+  secret_key = %q
+}
+`, os.Getenv("JUICEFS_ACCESS_KEY"), os.Getenv("JUICEFS_SECRET_KEY"))
+}
+
+// testAccVolumeChildImportID returns the `<volume id>/<id>` import identifier
+// of a resource nested under a volume.
+func testAccVolumeChildImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["volume"], rs.Primary.ID), nil
+	}
+}
+
+func TestProviderConfigure(t *testing.T) {
+	ctx := context.Background()
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	p := New("test")()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := func(endpoint, caCertPEM tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"endpoint":             endpoint,
+				"access_key":           tftypes.NewValue(tftypes.String, "access"),
+				"secret_key":           tftypes.NewValue(tftypes.String, "secret"),
+				"ca_cert_pem":          caCertPEM,
+				"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":            tftypes.NewValue(tftypes.String, nil),
+				"client_cert_pem":      tftypes.NewValue(tftypes.String, nil),
+				"client_key_pem":       tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+	noCA := tftypes.NewValue(tftypes.String, nil)
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config(tftypes.NewValue(tftypes.String, srv.URL), noCA)}, resp)
+	if resp.Diagnostics.HasError() || resp.ResourceData == nil {
+		t.Errorf("Configure() = %v, want a client", resp.Diagnostics)
+	}
+
+	unknownCA := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	resp = &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config(tftypes.NewValue(tftypes.String, srv.URL), unknownCA)}, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 || resp.ResourceData == nil {
+		t.Errorf("Configure() with an unknown ca_cert_pem = %v, want a warning and a client", resp.Diagnostics)
+	}
+
+	resp = &provider.ConfigureResponse{}
+	req := provider.ConfigureRequest{Config: config(tftypes.NewValue(tftypes.String, srv.URL), unknownCA)}
+	req.ClientCapabilities.DeferralAllowed = true
+	p.Configure(ctx, req, resp)
+	if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Errorf("Configure() with deferral allowed = %+v, want deferred", resp.Deferred)
+	}
+
+	if requests != 0 {
+		t.Errorf("Configure() made %d API requests, want none", requests)
+	}
+}
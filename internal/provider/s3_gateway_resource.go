@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &S3GatewayResource{}
+var _ resource.ResourceWithImportState = &S3GatewayResource{}
+
+func NewS3GatewayResource() resource.Resource {
+	return &S3GatewayResource{}
+}
+
+// S3GatewayResource defines the resource implementation.
+type S3GatewayResource struct {
+	client *juicefs.Client
+}
+
+// S3GatewayResourceModel describes the resource data model.
+type S3GatewayResourceModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Volume    types.Int64  `tfsdk:"volume"`
+	Region    types.Int64  `tfsdk:"region"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	ReadOnly  types.Bool   `tfsdk:"read_only"`
+	Subpath   types.String `tfsdk:"subpath"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func (m S3GatewayResourceModel) toAPI() juicefs.S3GatewayRequest {
+	req := juicefs.S3GatewayRequest{
+		Region:    m.Region.ValueInt64(),
+		AccessKey: m.AccessKey.ValueString(),
+		SecretKey: m.SecretKey.ValueString(),
+		ReadOnly:  m.ReadOnly.ValueBool(),
+	}
+	if !m.Subpath.IsNull() && !m.Subpath.IsUnknown() {
+		subpath := m.Subpath.ValueString()
+		req.Subpath = &subpath
+	}
+	return req
+}
+
+// fromAPI copies the gateway into the model. The secret key is never returned
+// by the API, so it is kept as configured.
+func (m *S3GatewayResourceModel) fromAPI(gateway *juicefs.S3Gateway) {
+	m.Id = types.Int64Value(gateway.Id)
+	m.Volume = types.Int64Value(gateway.Volume)
+	m.Region = types.Int64Value(gateway.Region)
+	m.AccessKey = types.StringValue(gateway.AccessKey)
+	m.ReadOnly = types.BoolValue(gateway.ReadOnly)
+	// The root may be returned as an empty path.
+	subpath := gateway.Subpath
+	if subpath == "" {
+		subpath = "/"
+	}
+	m.Subpath = types.StringValue(subpath)
+	m.Endpoint = types.StringValue(gateway.Endpoint)
+	m.Status = types.StringValue(gateway.Status)
+}
+
+func (r *S3GatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_gateway"
+}
+
+func (r *S3GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "S3 compatible gateway in front of a volume",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Gateway identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.Int64Attribute{
+				MarkdownDescription: "Region to run the gateway in",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: "Access key clients use to sign S3 requests",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "Secret key clients use to sign S3 requests",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Reject write requests, default to false",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"subpath": schema.StringAttribute{
+				MarkdownDescription: "Subdirectory of the volume exposed by the gateway, default to `/`, the root",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("/"),
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "URL of the S3 endpoint",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the gateway",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *S3GatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitForRunning polls the gateway until it is running and returns its final
+// state.
+func (r *S3GatewayResource) waitForRunning(ctx context.Context, volumeID int64, gatewayID int64) (*juicefs.S3Gateway, error) {
+	var gateway *juicefs.S3Gateway
+	err := waitUntil(ctx, func() (bool, error) {
+		var err error
+		gateway, err = r.client.GetS3Gateway(volumeID, gatewayID)
+		if err != nil {
+			return false, err
+		}
+		if gateway.Status == juicefs.S3GatewayStatusFailed {
+			return false, fmt.Errorf("s3 gateway %d failed to start", gatewayID)
+		}
+		return gateway.Status == juicefs.S3GatewayStatusRunning, nil
+	})
+	return gateway, err
+}
+
+func (r *S3GatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data S3GatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	gateway, err := r.client.CreateS3Gateway(volumeID, data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create s3 gateway, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("s3 gateway created: volume=%d ID=%d", volumeID, gateway.Id))
+
+	gateway, err = r.waitForRunning(ctx, volumeID, gateway.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check s3 gateway status, got error: %s", err))
+		return
+	}
+	data.fromAPI(gateway)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *S3GatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data S3GatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway, err := r.client.GetS3Gateway(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read s3 gateway, got error: %s", err))
+		return
+	}
+	data.fromAPI(gateway)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *S3GatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data S3GatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	gateway, err := r.client.UpdateS3Gateway(volumeID, data.Id.ValueInt64(), data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update s3 gateway, got error: %s", err))
+		return
+	}
+	gateway, err = r.waitForRunning(ctx, volumeID, gateway.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check s3 gateway status, got error: %s", err))
+		return
+	}
+	data.fromAPI(gateway)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *S3GatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data S3GatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteS3Gateway(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete s3 gateway, got error: %s", err))
+		return
+	}
+}
+
+func (r *S3GatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, gatewayID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/gateway_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), gatewayID)...)
+}
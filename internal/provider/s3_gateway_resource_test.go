@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestS3GatewayResourceModel(t *testing.T) {
+	var m S3GatewayResourceModel
+	m.fromAPI(&juicefs.S3Gateway{Id: 1, Volume: 2, Region: 3, AccessKey: "gw", Subpath: ""})
+	if got := m.Subpath.ValueString(); got != "/" {
+		t.Errorf("subpath = %q, want %q", got, "/")
+	}
+	m.fromAPI(&juicefs.S3Gateway{Id: 1, Volume: 2, Region: 3, AccessKey: "gw", Subpath: "/data"})
+	if got := m.Subpath.ValueString(); got != "/data" {
+		t.Errorf("subpath = %q, want %q", got, "/data")
+	}
+
+	if req := m.toAPI(); req.Subpath == nil || *req.Subpath != "/data" {
+		t.Errorf("toAPI() subpath = %v, want /data", req.Subpath)
+	}
+	m.Subpath = types.StringUnknown()
+	if req := m.toAPI(); req.Subpath != nil {
+		t.Errorf("toAPI() subpath = %q, want unset while unknown", *req.Subpath)
+	}
+}
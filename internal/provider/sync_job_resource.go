@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SyncJobResource{}
+var _ resource.ResourceWithImportState = &SyncJobResource{}
+
+// defaultSyncTimeout bounds how long Create waits for a sync job when
+// wait_for_completion is set and no create timeout is configured.
+const defaultSyncTimeout = 2 * time.Hour
+
+func NewSyncJobResource() resource.Resource {
+	return &SyncJobResource{}
+}
+
+// SyncJobResource defines the resource implementation.
+type SyncJobResource struct {
+	client *juicefs.Client
+}
+
+// SyncJobResourceModel describes the resource data model.
+type SyncJobResourceModel struct {
+	Id                types.Int64    `tfsdk:"id"`
+	Volume            types.Int64    `tfsdk:"volume"`
+	Source            types.String   `tfsdk:"source"`
+	SourceAccessKey   types.String   `tfsdk:"source_access_key"`
+	SourceSecretKey   types.String   `tfsdk:"source_secret_key"`
+	Path              types.String   `tfsdk:"path"`
+	Include           types.List     `tfsdk:"include"`
+	Exclude           types.List     `tfsdk:"exclude"`
+	Threads           types.Int64    `tfsdk:"threads"`
+	DeleteDst         types.Bool     `tfsdk:"delete_dst"`
+	Schedule          types.String   `tfsdk:"schedule"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Status            types.String   `tfsdk:"status"`
+	BytesCopied       types.Int64    `tfsdk:"bytes_copied"`
+	FilesCopied       types.Int64    `tfsdk:"files_copied"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m SyncJobResourceModel) toAPI(ctx context.Context) (juicefs.SyncJobRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := juicefs.SyncJobRequest{
+		Source:          m.Source.ValueString(),
+		SourceAccessKey: m.SourceAccessKey.ValueString(),
+		SourceSecretKey: m.SourceSecretKey.ValueString(),
+		Path:            m.Path.ValueString(),
+		Include:         []string{},
+		Exclude:         []string{},
+		Threads:         m.Threads.ValueInt64(),
+		DeleteDst:       m.DeleteDst.ValueBool(),
+		Schedule:        m.Schedule.ValueString(),
+	}
+	if !m.Include.IsNull() {
+		diags.Append(m.Include.ElementsAs(ctx, &req.Include, false)...)
+	}
+	if !m.Exclude.IsNull() {
+		diags.Append(m.Exclude.ElementsAs(ctx, &req.Exclude, false)...)
+	}
+	return req, diags
+}
+
+// fromAPI copies the job into the model. The source credentials are never
+// returned, so they are left as configured.
+func (m *SyncJobResourceModel) fromAPI(ctx context.Context, job *juicefs.SyncJob) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.Id = types.Int64Value(job.Id)
+	m.Volume = types.Int64Value(job.Volume)
+	m.Source = types.StringValue(job.Source)
+	m.Path = types.StringValue(job.Path)
+	m.Threads = types.Int64Value(job.Threads)
+	m.DeleteDst = types.BoolValue(job.DeleteDst)
+	if job.Schedule != "" || !m.Schedule.IsNull() {
+		m.Schedule = types.StringValue(job.Schedule)
+	}
+	if len(job.Include) > 0 || !m.Include.IsNull() {
+		include := job.Include
+		if include == nil {
+			include = []string{}
+		}
+		m.Include, d = types.ListValueFrom(ctx, types.StringType, include)
+		diags.Append(d...)
+	}
+	if len(job.Exclude) > 0 || !m.Exclude.IsNull() {
+		exclude := job.Exclude
+		if exclude == nil {
+			exclude = []string{}
+		}
+		m.Exclude, d = types.ListValueFrom(ctx, types.StringType, exclude)
+		diags.Append(d...)
+	}
+	m.Status = types.StringValue(job.Status)
+	m.BytesCopied = types.Int64Value(job.BytesCopied)
+	m.FilesCopied = types.Int64Value(job.FilesCopied)
+	return diags
+}
+
+func (r *SyncJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_job"
+}
+
+func (r *SyncJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cloud-managed job copying data from an object storage bucket into a volume, once or on a schedule",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Sync job identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Destination volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "URI of the data to copy, e.g. `s3://bucket/prefix/` or `gs://bucket/prefix/`",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"source_access_key": schema.StringAttribute{
+				MarkdownDescription: "Access key of the source bucket",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"source_secret_key": schema.StringAttribute{
+				MarkdownDescription: "Secret key of the source bucket",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Destination directory in the volume, default to the root",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("/"),
+			},
+			"include": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only copy keys matching these patterns",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"exclude": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Skip keys matching these patterns",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"threads": schema.Int64Attribute{
+				MarkdownDescription: "Number of concurrent copy threads, default to the service default",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_dst": schema.BoolAttribute{
+				MarkdownDescription: "Delete files in the destination that do not exist in the source, default to false",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression to run the job periodically, run once if unset",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the first run of the job to finish when creating it, default to false",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"bytes_copied": schema.Int64Attribute{
+				MarkdownDescription: "Bytes copied by the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"files_copied": schema.Int64Attribute{
+				MarkdownDescription: "Files copied by the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *SyncJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitForCompletion polls the sync job until its run succeeds and returns its
+// last known state, even when waiting fails.
+func (r *SyncJobResource) waitForCompletion(ctx context.Context, volumeID int64, job *juicefs.SyncJob) (*juicefs.SyncJob, error) {
+	err := waitUntil(ctx, func() (bool, error) {
+		current, err := r.client.GetSyncJob(volumeID, job.Id)
+		if err != nil {
+			return false, err
+		}
+		job = current
+		tflog.Debug(ctx, fmt.Sprintf("sync job %d: status=%s files=%d bytes=%d", job.Id, job.Status, job.FilesCopied, job.BytesCopied))
+		if job.Status == juicefs.SyncJobStatusFailed {
+			return false, fmt.Errorf("sync job %d failed: %s", job.Id, job.Error)
+		}
+		return job.Status == juicefs.SyncJobStatusSucceeded, nil
+	})
+	return job, err
+}
+
+func (r *SyncJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SyncJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultSyncTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	job, err := r.client.CreateSyncJob(volumeID, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sync job, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("sync job created: volume=%d ID=%d", volumeID, job.Id))
+
+	if data.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+		job, err = r.waitForCompletion(waitCtx, volumeID, job)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete sync job %d, got error: %s", job.Id, err))
+		}
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+
+	// Save data into Terraform state, so that an unfinished job is tainted
+	// rather than forgotten.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SyncJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SyncJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.GetSyncJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sync job, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SyncJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SyncJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.UpdateSyncJob(data.Volume.ValueInt64(), data.Id.ValueInt64(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sync job, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SyncJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SyncJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSyncJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sync job, got error: %s", err))
+		return
+	}
+}
+
+func (r *SyncJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, jobID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/sync_job_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), jobID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), false)...)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSyncJobResourceModelFromAPI(t *testing.T) {
+	ctx := context.Background()
+	job := &juicefs.SyncJob{Id: 1, Volume: 2, Source: "s3://bucket", Path: "/", Status: juicefs.SyncJobStatusSucceeded}
+
+	m := SyncJobResourceModel{
+		Schedule: types.StringNull(),
+		Include:  types.ListValueMust(types.StringType, nil),
+		Exclude:  types.ListNull(types.StringType),
+	}
+	if diags := m.fromAPI(ctx, job); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !m.Schedule.IsNull() || !m.Exclude.IsNull() {
+		t.Errorf("schedule = %s, exclude = %s, want null", m.Schedule, m.Exclude)
+	}
+	if m.Include.IsNull() || len(m.Include.Elements()) != 0 {
+		t.Errorf("include = %s, want an empty list", m.Include)
+	}
+
+	job.Schedule = "0 * * * *"
+	if diags := m.fromAPI(ctx, job); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Schedule.ValueString() != "0 * * * *" {
+		t.Errorf("schedule = %s, want the schedule set on the server", m.Schedule)
+	}
+}
+
+func TestSyncJobResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &SyncJobResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	newResp := func() *fwresource.ImportStateResponse {
+		return &fwresource.ImportStateResponse{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}
+	}
+
+	resp := newResp()
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "2/1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() = %v", resp.Diagnostics)
+	}
+	var volume, id types.Int64
+	var wait types.Bool
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("volume"), &volume)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("wait_for_completion"), &wait)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if volume.ValueInt64() != 2 || id.ValueInt64() != 1 || !wait.Equal(types.BoolValue(false)) {
+		t.Errorf("imported volume = %s, id = %s, wait_for_completion = %s", volume, id, wait)
+	}
+
+	resp = newResp()
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "1"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("ImportState() with a bare id succeeded, want error")
+	}
+}
@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumeCloneResource{}
+var _ resource.ResourceWithImportState = &VolumeCloneResource{}
+
+func NewVolumeCloneResource() resource.Resource {
+	return &VolumeCloneResource{}
+}
+
+// VolumeCloneResource defines the resource implementation.
+type VolumeCloneResource struct {
+	client *juicefs.Client
+}
+
+// VolumeCloneResourceModel describes the resource data model.
+type VolumeCloneResourceModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	SourceVolumeId types.Int64  `tfsdk:"source_volume_id"`
+	SourcePath     types.String `tfsdk:"source_path"`
+	Snapshot       types.Bool   `tfsdk:"snapshot"`
+	RootVolumeId   types.Int64  `tfsdk:"root_volume_id"`
+	ClonedAt       types.String `tfsdk:"cloned_at"`
+	Uuid           types.String `tfsdk:"uuid"`
+	Region         types.Int64  `tfsdk:"region"`
+	Bucket         types.String `tfsdk:"bucket"`
+	Created        types.String `tfsdk:"created"`
+}
+
+func (m *VolumeCloneResourceModel) fromAPI(volume *juicefs.Volume) {
+	m.Id = types.Int64Value(volume.Id)
+	m.Name = types.StringValue(volume.Name)
+	m.Uuid = types.StringValue(volume.Uuid)
+	m.Region = types.Int64Value(volume.Region)
+	m.Bucket = types.StringValue(volume.Bucket)
+	m.Created = types.StringValue(volume.Created.Format(time.RFC3339))
+	if lineage := volume.Lineage; lineage != nil {
+		m.SourceVolumeId = types.Int64Value(lineage.SourceVolume)
+		m.SourcePath = types.StringValue(lineage.SourcePath)
+		m.Snapshot = types.BoolValue(lineage.Snapshot)
+		m.RootVolumeId = types.Int64Value(lineage.RootVolume)
+		m.ClonedAt = types.StringValue(lineage.ClonedAt.Format(time.RFC3339))
+	} else {
+		// The volume is not a clone, or no longer records where it came from.
+		m.RootVolumeId = types.Int64Null()
+		m.ClonedAt = types.StringNull()
+	}
+}
+
+func (r *VolumeCloneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_clone"
+}
+
+func (r *VolumeCloneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metadata-level clone of a volume or one of its directories. " +
+			"The clone shares data with its source and only stores what is written to it afterwards.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Volume identifier of the clone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the clone",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_volume_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the volume to clone",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Directory of the source volume to clone, default to the whole volume",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("/"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot": schema.BoolAttribute{
+				MarkdownDescription: "Create a read-only snapshot instead of a writable clone, default to false",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"root_volume_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the original volume when the source is itself a clone",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cloned_at": schema.StringAttribute{
+				MarkdownDescription: "Point in time of the source the clone reflects",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "Volume UUID of the clone",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.Int64Attribute{
+				MarkdownDescription: "Region of the clone, the same as the source",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "Bucket of the clone, shared with the source",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the clone",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VolumeCloneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VolumeCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeCloneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := data.SourceVolumeId.ValueInt64()
+	volume, err := r.client.CloneVolume(sourceID, juicefs.CloneVolumeRequest{
+		Name:     data.Name.ValueString(),
+		Path:     data.SourcePath.ValueString(),
+		Snapshot: data.Snapshot.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clone volume, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("volume cloned: source=%d name=%s ID=%d", sourceID, volume.Name, volume.Id))
+
+	err = waitUntil(ctx, func() (bool, error) {
+		return r.client.IsVolumeReady(volume.Id)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check volume status, got error: %s", err))
+		return
+	}
+
+	volume, err = r.client.GetVolume(volume.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get volume, got error: %s", err))
+		return
+	}
+	data.fromAPI(volume)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeCloneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err := r.client.GetVolume(data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
+		return
+	}
+	data.fromAPI(volume)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VolumeCloneResourceModel
+
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeCloneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting a clone leaves its source untouched.
+	err := r.client.DeleteVolume(data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume, got error: %s", err))
+		return
+	}
+}
+
+func (r *VolumeCloneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected a numeric volume identifier. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
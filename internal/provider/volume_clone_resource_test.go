@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVolumeCloneResourceModelFromAPI(t *testing.T) {
+	clonedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	volume := &juicefs.Volume{
+		Id:   2,
+		Name: "clone",
+		Lineage: &juicefs.VolumeLineage{
+			SourceVolume: 1,
+			SourcePath:   "/data",
+			RootVolume:   1,
+			Snapshot:     true,
+			ClonedAt:     clonedAt,
+		},
+	}
+
+	var m VolumeCloneResourceModel
+	m.fromAPI(volume)
+	if m.SourceVolumeId.ValueInt64() != 1 || m.SourcePath.ValueString() != "/data" || !m.Snapshot.ValueBool() {
+		t.Errorf("source = %s %s %s", m.SourceVolumeId, m.SourcePath, m.Snapshot)
+	}
+	if m.RootVolumeId.ValueInt64() != 1 || m.ClonedAt.ValueString() != "2024-01-02T03:04:05Z" {
+		t.Errorf("root_volume_id = %s, cloned_at = %s", m.RootVolumeId, m.ClonedAt)
+	}
+
+	// Without lineage the configured source is kept and the computed
+	// attributes are cleared.
+	volume.Lineage = nil
+	m.fromAPI(volume)
+	if m.SourceVolumeId.ValueInt64() != 1 || m.SourcePath.ValueString() != "/data" {
+		t.Errorf("source = %s %s, want the configured values", m.SourceVolumeId, m.SourcePath)
+	}
+	if !m.RootVolumeId.Equal(types.Int64Null()) || !m.ClonedAt.Equal(types.StringNull()) {
+		t.Errorf("root_volume_id = %s, cloned_at = %s, want null", m.RootVolumeId, m.ClonedAt)
+	}
+}
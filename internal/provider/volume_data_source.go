@@ -42,10 +42,11 @@ func (VolumeAccessRulesDataSourceModel) schema() schema.NestedAttributeObject {
 				Computed:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Token for access rules",
+				MarkdownDescription: "Token for access rules, null when `omit_tokens` is set",
 				Required:            false,
 				Optional:            false,
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"read_only": schema.BoolAttribute{
 				MarkdownDescription: "Read-only access",
@@ -76,6 +77,7 @@ func (VolumeAccessRulesDataSourceModel) attrType() map[string]attr.Type {
 type VolumeDataSourceModel struct {
 	Id          types.Int64  `tfsdk:"id"`
 	AccessRules types.List   `tfsdk:"access_rules"`
+	OmitTokens  types.Bool   `tfsdk:"omit_tokens"`
 	Owner       types.Int64  `tfsdk:"owner"`
 	Size        types.Int64  `tfsdk:"size"`
 	Inodes      types.Int64  `tfsdk:"inodes"`
@@ -115,6 +117,12 @@ func (d *VolumeDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed:            true,
 				NestedObject:        VolumeAccessRulesDataSourceModel{}.schema(),
 			},
+			"omit_tokens": schema.BoolAttribute{
+				MarkdownDescription: "Do not store access rule tokens in state, for when they are fetched another way",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
 			"owner": schema.Int64Attribute{
 				MarkdownDescription: "Owner of the volume",
 				Required:            false,
@@ -247,9 +255,13 @@ func (d *VolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			data.Id = types.Int64Value(volume.Id)
 			accessRules := make([]VolumeAccessRulesDataSourceModel, 0)
 			for _, accessRule := range volume.AccessRules {
+				token := types.StringValue(accessRule.Token)
+				if data.OmitTokens.ValueBool() {
+					token = types.StringNull()
+				}
 				accessRules = append(accessRules, VolumeAccessRulesDataSourceModel{
 					IpRange:    types.StringValue(accessRule.IpRange),
-					Token:      types.StringValue(accessRule.Token),
+					Token:      token,
 					ReadOnly:   types.BoolValue(accessRule.ReadOnly),
 					AppendOnly: types.BoolValue(accessRule.AppendOnly),
 				})
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumeMaintenanceResource{}
+var _ resource.ResourceWithImportState = &VolumeMaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &VolumeMaintenanceResource{}
+
+// defaultMaintenanceTimeout bounds how long a maintenance run is waited for
+// when no timeout is configured.
+const defaultMaintenanceTimeout = time.Hour
+
+func NewVolumeMaintenanceResource() resource.Resource {
+	return &VolumeMaintenanceResource{}
+}
+
+// VolumeMaintenanceResource defines the resource implementation.
+type VolumeMaintenanceResource struct {
+	client *juicefs.Client
+}
+
+// VolumeMaintenanceResourceModel describes the resource data model.
+type VolumeMaintenanceResourceModel struct {
+	Id             types.Int64    `tfsdk:"id"`
+	Volume         types.Int64    `tfsdk:"volume"`
+	Operation      types.String   `tfsdk:"operation"`
+	Schedule       types.String   `tfsdk:"schedule"`
+	Triggers       types.Map      `tfsdk:"triggers"`
+	Status         types.String   `tfsdk:"status"`
+	LastRun        types.String   `tfsdk:"last_run"`
+	LeakedObjects  types.Int64    `tfsdk:"leaked_objects"`
+	BytesReclaimed types.Int64    `tfsdk:"bytes_reclaimed"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m VolumeMaintenanceResourceModel) toAPI() juicefs.MaintenanceJobRequest {
+	return juicefs.MaintenanceJobRequest{
+		Operation: m.Operation.ValueString(),
+		Schedule:  m.Schedule.ValueString(),
+	}
+}
+
+func (m *VolumeMaintenanceResourceModel) fromAPI(job *juicefs.MaintenanceJob) {
+	m.Id = types.Int64Value(job.Id)
+	m.Volume = types.Int64Value(job.Volume)
+	m.Operation = types.StringValue(job.Operation)
+	if job.Schedule != "" || !m.Schedule.IsNull() {
+		m.Schedule = types.StringValue(job.Schedule)
+	}
+	m.Status = types.StringValue(job.Status)
+	if job.LastRun != nil {
+		m.LastRun = types.StringValue(job.LastRun.Format(time.RFC3339))
+	} else {
+		m.LastRun = types.StringNull()
+	}
+	m.LeakedObjects = types.Int64Value(job.LeakedObjects)
+	m.BytesReclaimed = types.Int64Value(job.BytesReclaimed)
+}
+
+func (r *VolumeMaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_maintenance"
+}
+
+func (r *VolumeMaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Maintenance job of a volume: garbage collection of leaked objects, metadata consistency check or slice compaction. " +
+			"The job runs when created, whenever `triggers` change and on its `schedule`, if any.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Maintenance job identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "Maintenance operation, one of `gc`, `fsck` or `compact`",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression to run the job periodically",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the job again when changed",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"last_run": schema.StringAttribute{
+				MarkdownDescription: "Start time of the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"leaked_objects": schema.Int64Attribute{
+				MarkdownDescription: "Leaked objects found by the last `gc` run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"bytes_reclaimed": schema.Int64Attribute{
+				MarkdownDescription: "Bytes reclaimed by the last run",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *VolumeMaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VolumeMaintenanceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Operation.IsUnknown() || data.Operation.IsNull() {
+		return
+	}
+	if !slices.Contains(juicefs.MaintenanceOperations, data.Operation.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operation"),
+			"Invalid Maintenance Operation",
+			fmt.Sprintf("Operation must be one of %s, got: %q.", strings.Join(juicefs.MaintenanceOperations, ", "), data.Operation.ValueString()),
+		)
+	}
+}
+
+func (r *VolumeMaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitForRun polls the maintenance job until its current run succeeds and
+// returns its last known state, even when waiting fails.
+func (r *VolumeMaintenanceResource) waitForRun(ctx context.Context, volumeID int64, job *juicefs.MaintenanceJob) (*juicefs.MaintenanceJob, error) {
+	err := waitUntil(ctx, func() (bool, error) {
+		current, err := r.client.GetMaintenanceJob(volumeID, job.Id)
+		if err != nil {
+			return false, err
+		}
+		job = current
+		if job.Status == juicefs.MaintenanceJobStatusFailed {
+			return false, fmt.Errorf("%s of volume %d failed: %s", job.Operation, volumeID, job.Error)
+		}
+		return job.Status == juicefs.MaintenanceJobStatusSucceeded, nil
+	})
+	return job, err
+}
+
+func (r *VolumeMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeMaintenanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultMaintenanceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	job, err := r.client.CreateMaintenanceJob(volumeID, data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance job, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("maintenance job created: volume=%d operation=%s ID=%d", volumeID, job.Operation, job.Id))
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	job, err = r.waitForRun(waitCtx, volumeID, job)
+	data.fromAPI(job)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete maintenance job %d, got error: %s", job.Id, err))
+	}
+
+	// Save data into Terraform state, so that an unfinished job is tainted
+	// rather than forgotten.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeMaintenanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.GetMaintenanceJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance job, got error: %s", err))
+		return
+	}
+	data.fromAPI(job)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VolumeMaintenanceResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultMaintenanceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	job, err := r.client.UpdateMaintenanceJob(volumeID, data.Id.ValueInt64(), data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance job, got error: %s", err))
+		return
+	}
+
+	if !data.Triggers.Equal(state.Triggers) {
+		job, err = r.client.RunMaintenanceJob(volumeID, job.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run maintenance job, got error: %s", err))
+			return
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+		defer cancel()
+		job, err = r.waitForRun(waitCtx, volumeID, job)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete maintenance job %d, got error: %s", job.Id, err))
+			// Keep the previous triggers so that the next apply runs the
+			// job again.
+			data.Triggers = state.Triggers
+		}
+	}
+	data.fromAPI(job)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeMaintenanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMaintenanceJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance job, got error: %s", err))
+		return
+	}
+}
+
+func (r *VolumeMaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, jobID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/maintenance_job_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), jobID)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVolumeMaintenanceResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+	runStatus := juicefs.MaintenanceJobStatusSucceeded
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/volumes/2/maintenance/1":
+			fmt.Fprint(w, `{"id":1,"volume":2,"operation":"gc","status":"succeeded"}`)
+		case r.Method == "POST" && r.URL.Path == "/volumes/2/maintenance/1/run":
+			fmt.Fprint(w, `{"id":1,"volume":2,"operation":"gc","status":"running"}`)
+		case r.Method == "GET" && r.URL.Path == "/volumes/2/maintenance/1":
+			fmt.Fprintf(w, `{"id":1,"volume":2,"operation":"gc","status":%q,"error":"bucket unreachable"}`, runStatus)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	r := &VolumeMaintenanceResource{client: &juicefs.Client{Endpoint: srv.URL, AccessKey: "access", SecretKey: "secret"}}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(trigger string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":        tftypes.NewValue(tftypes.Number, 1),
+			"volume":    tftypes.NewValue(tftypes.Number, 2),
+			"operation": tftypes.NewValue(tftypes.String, "gc"),
+			"schedule":  tftypes.NewValue(tftypes.String, nil),
+			"triggers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"release": tftypes.NewValue(tftypes.String, trigger),
+			}),
+			"status":          tftypes.NewValue(tftypes.String, juicefs.MaintenanceJobStatusSucceeded),
+			"last_run":        tftypes.NewValue(tftypes.String, nil),
+			"leaked_objects":  tftypes.NewValue(tftypes.Number, 0),
+			"bytes_reclaimed": tftypes.NewValue(tftypes.Number, 0),
+			"timeouts":        tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		runStatus   string
+		wantErr     bool
+		wantTrigger string
+	}{
+		{"run succeeded", juicefs.MaintenanceJobStatusSucceeded, false, "v2"},
+		{"run failed", juicefs.MaintenanceJobStatusFailed, true, "v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runStatus = tt.runStatus
+			req := fwresource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: value("v2")},
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: value("v1")},
+			}
+			resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: value("v1")}}
+			r.Update(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("Update() = %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+
+			var got VolumeMaintenanceResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			want := types.MapValueMust(types.StringType, map[string]attr.Value{"release": types.StringValue(tt.wantTrigger)})
+			if !got.Triggers.Equal(want) {
+				t.Errorf("triggers = %s, want %s", got.Triggers, want)
+			}
+			if got.Status.ValueString() != tt.runStatus {
+				t.Errorf("status = %s, want %s", got.Status, tt.runStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &VolumeMetricsDataSource{}
+
+func NewVolumeMetricsDataSource() datasource.DataSource {
+	return &VolumeMetricsDataSource{}
+}
+
+// VolumeMetricsDataSource defines the data source implementation.
+type VolumeMetricsDataSource struct {
+	client *juicefs.Client
+}
+
+type MetricPointDataSourceModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+func (MetricPointDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"timestamp": types.StringType,
+		"value":     types.Float64Type,
+	}
+}
+
+type MetricSeriesDataSourceModel struct {
+	Name   types.String  `tfsdk:"name"`
+	Min    types.Float64 `tfsdk:"min"`
+	Max    types.Float64 `tfsdk:"max"`
+	Avg    types.Float64 `tfsdk:"avg"`
+	Last   types.Float64 `tfsdk:"last"`
+	Points types.List    `tfsdk:"points"`
+}
+
+func (MetricSeriesDataSourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Metric name",
+				Computed:            true,
+			},
+			"min": schema.Float64Attribute{
+				MarkdownDescription: "Minimum value in the window",
+				Computed:            true,
+			},
+			"max": schema.Float64Attribute{
+				MarkdownDescription: "Maximum value in the window",
+				Computed:            true,
+			},
+			"avg": schema.Float64Attribute{
+				MarkdownDescription: "Average value in the window",
+				Computed:            true,
+			},
+			"last": schema.Float64Attribute{
+				MarkdownDescription: "Latest value in the window",
+				Computed:            true,
+			},
+			"points": schema.ListNestedAttribute{
+				MarkdownDescription: "Data points of the series",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "Time of the data point, in RFC 3339 format",
+							Computed:            true,
+						},
+						"value": schema.Float64Attribute{
+							MarkdownDescription: "Value of the data point",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (MetricSeriesDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"min":  types.Float64Type,
+		"max":  types.Float64Type,
+		"avg":  types.Float64Type,
+		"last": types.Float64Type,
+		"points": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: MetricPointDataSourceModel{}.attrType()},
+		},
+	}
+}
+
+// VolumeMetricsDataSourceModel describes the data source data model.
+type VolumeMetricsDataSourceModel struct {
+	Volume  types.Int64  `tfsdk:"volume"`
+	Start   types.String `tfsdk:"start"`
+	End     types.String `tfsdk:"end"`
+	Step    types.Int64  `tfsdk:"step"`
+	Metrics types.List   `tfsdk:"metrics"`
+	Series  types.List   `tfsdk:"series"`
+}
+
+func (d *VolumeMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_metrics"
+}
+
+func (d *VolumeMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Usage and throughput history of a volume",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"start": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Start of the window in RFC 3339 format, default to 24 hours before `end`",
+			},
+			"end": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "End of the window in RFC 3339 format, default to now",
+			},
+			"step": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Resolution of the series in seconds, chosen by the server if unset",
+			},
+			"metrics": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Metrics to query, one of `used_bytes`, `inodes`, `read_bytes`, `write_bytes` and `ops`. All of them if unset",
+			},
+			"series": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Aggregated series for the window",
+				NestedObject:        MetricSeriesDataSourceModel{}.schema(),
+			},
+		},
+	}
+}
+
+func (d *VolumeMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumeMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := juicefs.VolumeMetricsQuery{
+		End: time.Now().UTC(),
+	}
+	if !data.End.IsNull() {
+		end, err := time.Parse(time.RFC3339, data.End.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid End Time", fmt.Sprintf("Unable to parse end %q: %s", data.End.ValueString(), err))
+			return
+		}
+		query.End = end
+	}
+	query.Start = query.End.Add(-24 * time.Hour)
+	if !data.Start.IsNull() {
+		start, err := time.Parse(time.RFC3339, data.Start.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Start Time", fmt.Sprintf("Unable to parse start %q: %s", data.Start.ValueString(), err))
+			return
+		}
+		query.Start = start
+	}
+	if !query.Start.Before(query.End) {
+		resp.Diagnostics.AddError("Invalid Time Window", "start must be before end")
+		return
+	}
+	if !data.Step.IsNull() {
+		query.Step = time.Duration(data.Step.ValueInt64()) * time.Second
+	}
+	if !data.Metrics.IsNull() {
+		resp.Diagnostics.Append(data.Metrics.ElementsAs(ctx, &query.Metrics, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	metrics, err := d.client.GetVolumeMetrics(data.Volume.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume metrics, got error: %s", err))
+		return
+	}
+
+	series := make([]MetricSeriesDataSourceModel, 0, len(metrics))
+	for _, metric := range metrics {
+		points := make([]MetricPointDataSourceModel, 0, len(metric.Points))
+		minValue, maxValue, sum := math.Inf(1), math.Inf(-1), 0.0
+		for _, point := range metric.Points {
+			points = append(points, MetricPointDataSourceModel{
+				Timestamp: types.StringValue(time.Unix(point.Timestamp, 0).UTC().Format(time.RFC3339)),
+				Value:     types.Float64Value(point.Value),
+			})
+			minValue = math.Min(minValue, point.Value)
+			maxValue = math.Max(maxValue, point.Value)
+			sum += point.Value
+		}
+		pointList, diag := types.ListValueFrom(ctx, types.ObjectType{
+			AttrTypes: MetricPointDataSourceModel{}.attrType(),
+		}, points)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		item := MetricSeriesDataSourceModel{
+			Name:   types.StringValue(metric.Name),
+			Min:    types.Float64Null(),
+			Max:    types.Float64Null(),
+			Avg:    types.Float64Null(),
+			Last:   types.Float64Null(),
+			Points: pointList,
+		}
+		if n := len(metric.Points); n > 0 {
+			item.Min = types.Float64Value(minValue)
+			item.Max = types.Float64Value(maxValue)
+			item.Avg = types.Float64Value(sum / float64(n))
+			item.Last = types.Float64Value(metric.Points[n-1].Value)
+		}
+		series = append(series, item)
+	}
+	seriesList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: MetricSeriesDataSourceModel{}.attrType(),
+	}, series)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Start.IsNull() {
+		data.Start = types.StringValue(query.Start.UTC().Format(time.RFC3339))
+	}
+	if data.End.IsNull() {
+		data.End = types.StringValue(query.End.UTC().Format(time.RFC3339))
+	}
+	data.Series = seriesList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumePermissionResource{}
+var _ resource.ResourceWithImportState = &VolumePermissionResource{}
+var _ resource.ResourceWithValidateConfig = &VolumePermissionResource{}
+
+func NewVolumePermissionResource() resource.Resource {
+	return &VolumePermissionResource{}
+}
+
+// VolumePermissionResource defines the resource implementation.
+type VolumePermissionResource struct {
+	client *juicefs.Client
+}
+
+// VolumePermissionResourceModel describes the resource data model.
+type VolumePermissionResourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Volume types.Int64  `tfsdk:"volume"`
+	Member types.Int64  `tfsdk:"member"`
+	Group  types.Int64  `tfsdk:"group"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func (m VolumePermissionResourceModel) toAPI() juicefs.VolumePermissionRequest {
+	req := juicefs.VolumePermissionRequest{
+		Role: m.Role.ValueString(),
+	}
+	if !m.Member.IsNull() {
+		member := m.Member.ValueInt64()
+		req.Member = &member
+	}
+	if !m.Group.IsNull() {
+		group := m.Group.ValueInt64()
+		req.Group = &group
+	}
+	return req
+}
+
+func (m *VolumePermissionResourceModel) fromAPI(permission *juicefs.VolumePermission) {
+	m.Id = types.Int64Value(permission.Id)
+	m.Volume = types.Int64Value(permission.Volume)
+	m.Member = types.Int64PointerValue(permission.Member)
+	m.Group = types.Int64PointerValue(permission.Group)
+	m.Role = types.StringValue(permission.Role)
+}
+
+func (r *VolumePermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_permission"
+}
+
+func (r *VolumePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a member or a group a role on a volume",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Permission identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"member": schema.Int64Attribute{
+				MarkdownDescription: "Member identifier, conflicts with `group`",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.Int64Attribute{
+				MarkdownDescription: "Group identifier, conflicts with `member`",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted on the volume",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+		},
+	}
+}
+
+func (r *VolumePermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VolumePermissionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Member.IsUnknown() || data.Group.IsUnknown() {
+		return
+	}
+	if data.Member.IsNull() == data.Group.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member"),
+			"Invalid Permission Grantee",
+			"Exactly one of member or group must be set.",
+		)
+	}
+}
+
+func (r *VolumePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VolumePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumePermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	permission, err := r.client.CreateVolumePermission(volumeID, data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create volume permission, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("volume permission created: volume=%d ID=%d", volumeID, permission.Id))
+
+	data.fromAPI(permission)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.GetVolumePermission(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume permission, got error: %s", err))
+		return
+	}
+	data.fromAPI(permission)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VolumePermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.UpdateVolumePermission(data.Volume.ValueInt64(), data.Id.ValueInt64(), data.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update volume permission, got error: %s", err))
+		return
+	}
+	data.fromAPI(permission)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVolumePermission(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume permission, got error: %s", err))
+		return
+	}
+}
+
+func (r *VolumePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, permissionID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/permission_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), permissionID)...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVolumePermissionResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &VolumePermissionResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	id := tftypes.NewValue(tftypes.Number, 3)
+	null := tftypes.NewValue(tftypes.Number, nil)
+	unknown := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+
+	tests := []struct {
+		name       string
+		member     tftypes.Value
+		group      tftypes.Value
+		wantErrors int
+	}{
+		{"member", id, null, 0},
+		{"group", null, id, 0},
+		{"both", id, id, 1},
+		{"neither", null, null, 1},
+		{"member not known yet", unknown, null, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.Number, nil),
+					"volume": tftypes.NewValue(tftypes.Number, 1),
+					"member": tt.member,
+					"group":  tt.group,
+					"role":   tftypes.NewValue(tftypes.String, "reader"),
+				}),
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("ValidateConfig() errors = %d, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestVolumePermissionResourceModel(t *testing.T) {
+	group := int64(5)
+	var m VolumePermissionResourceModel
+	m.fromAPI(&juicefs.VolumePermission{Id: 1, Volume: 2, Group: &group, Role: "admin"})
+	if !m.Member.IsNull() || m.Group.ValueInt64() != group {
+		t.Errorf("member = %s, group = %s, want null and %d", m.Member, m.Group, group)
+	}
+
+	req := m.toAPI()
+	if req.Member != nil || req.Group == nil || *req.Group != group || req.Role != "admin" {
+		t.Errorf("toAPI() = %+v", req)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &VolumeQuotasDataSource{}
+
+func NewVolumeQuotasDataSource() datasource.DataSource {
+	return &VolumeQuotasDataSource{}
+}
+
+// VolumeQuotasDataSource defines the data source implementation.
+type VolumeQuotasDataSource struct {
+	client *juicefs.Client
+}
+
+type VolumeQuotaDataSourceModel struct {
+	Id            types.Int64   `tfsdk:"id"`
+	Path          types.String  `tfsdk:"path"`
+	SizeLimit     types.Int64   `tfsdk:"size_limit"`
+	InodeLimit    types.Int64   `tfsdk:"inode_limit"`
+	UsedBytes     types.Int64   `tfsdk:"used_bytes"`
+	UsedInodes    types.Int64   `tfsdk:"used_inodes"`
+	SizePercent   types.Float64 `tfsdk:"size_percent"`
+	InodesPercent types.Float64 `tfsdk:"inodes_percent"`
+}
+
+func (VolumeQuotaDataSourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Quota identifier",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Directory the quota applies to",
+				Computed:            true,
+			},
+			"size_limit": schema.Int64Attribute{
+				MarkdownDescription: "Size limit in bytes, 0 if unlimited",
+				Computed:            true,
+			},
+			"inode_limit": schema.Int64Attribute{
+				MarkdownDescription: "Inode limit, 0 if unlimited",
+				Computed:            true,
+			},
+			"used_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Bytes currently used under the directory",
+				Computed:            true,
+			},
+			"used_inodes": schema.Int64Attribute{
+				MarkdownDescription: "Inodes currently used under the directory",
+				Computed:            true,
+			},
+			"size_percent": schema.Float64Attribute{
+				MarkdownDescription: "Used bytes as a percentage of the size limit, null if unlimited",
+				Computed:            true,
+			},
+			"inodes_percent": schema.Float64Attribute{
+				MarkdownDescription: "Used inodes as a percentage of the inode limit, null if unlimited",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (VolumeQuotaDataSourceModel) attrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.Int64Type,
+		"path":           types.StringType,
+		"size_limit":     types.Int64Type,
+		"inode_limit":    types.Int64Type,
+		"used_bytes":     types.Int64Type,
+		"used_inodes":    types.Int64Type,
+		"size_percent":   types.Float64Type,
+		"inodes_percent": types.Float64Type,
+	}
+}
+
+// VolumeQuotasDataSourceModel describes the data source data model.
+type VolumeQuotasDataSourceModel struct {
+	Volume types.Int64 `tfsdk:"volume"`
+	Quotas types.List  `tfsdk:"quotas"`
+}
+
+func (d *VolumeQuotasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_quotas"
+}
+
+func (d *VolumeQuotasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directory quotas of a volume and their current usage",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"quotas": schema.ListNestedAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Quotas of the volume",
+				NestedObject:        VolumeQuotaDataSourceModel{}.schema(),
+			},
+		},
+	}
+}
+
+func (d *VolumeQuotasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumeQuotasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeQuotasDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotas, err := d.client.GetVolumeQuotas(data.Volume.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume quotas, got error: %s", err))
+		return
+	}
+
+	items := make([]VolumeQuotaDataSourceModel, 0, len(quotas))
+	for _, quota := range quotas {
+		item := VolumeQuotaDataSourceModel{
+			Id:            types.Int64Value(quota.Id),
+			Path:          types.StringValue(quota.Path),
+			SizeLimit:     types.Int64Value(quota.Size),
+			InodeLimit:    types.Int64Value(quota.Inodes),
+			UsedBytes:     types.Int64Value(quota.UsedSize),
+			UsedInodes:    types.Int64Value(quota.UsedInodes),
+			SizePercent:   types.Float64Null(),
+			InodesPercent: types.Float64Null(),
+		}
+		if p, ok := quota.SizePercent(); ok {
+			item.SizePercent = types.Float64Value(p)
+		}
+		if p, ok := quota.InodesPercent(); ok {
+			item.InodesPercent = types.Float64Value(p)
+		}
+		items = append(items, item)
+	}
+	quotaList, diag := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: VolumeQuotaDataSourceModel{}.attrType(),
+	}, items)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Quotas = quotaList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
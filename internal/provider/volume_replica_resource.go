@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumeReplicaResource{}
+var _ resource.ResourceWithImportState = &VolumeReplicaResource{}
+
+func NewVolumeReplicaResource() resource.Resource {
+	return &VolumeReplicaResource{}
+}
+
+// VolumeReplicaResource defines the resource implementation.
+type VolumeReplicaResource struct {
+	client *juicefs.Client
+}
+
+// VolumeReplicaResourceModel describes the resource data model.
+type VolumeReplicaResourceModel struct {
+	Id      types.Int64  `tfsdk:"id"`
+	Volume  types.Int64  `tfsdk:"volume"`
+	Region  types.Int64  `tfsdk:"region"`
+	Status  types.String `tfsdk:"status"`
+	Lag     types.Int64  `tfsdk:"lag"`
+	Created types.String `tfsdk:"created"`
+}
+
+func (m *VolumeReplicaResourceModel) fromAPI(replica *juicefs.VolumeReplica) {
+	m.Id = types.Int64Value(replica.Id)
+	m.Volume = types.Int64Value(replica.Volume)
+	m.Region = types.Int64Value(replica.Region)
+	m.Status = types.StringValue(replica.Status)
+	m.Lag = types.Int64Value(replica.Lag)
+	m.Created = types.StringValue(replica.Created.Format(time.RFC3339))
+}
+
+func (r *VolumeReplicaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_replica"
+}
+
+func (r *VolumeReplicaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Replicates the metadata and data of a volume to another region",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Replica identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Source volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.Int64Attribute{
+				MarkdownDescription: "Target region identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Replication status",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"lag": schema.Int64Attribute{
+				MarkdownDescription: "Replication lag in seconds",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the replica",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VolumeReplicaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VolumeReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeReplicaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	replica, err := r.client.CreateVolumeReplica(volumeID, juicefs.CreateVolumeReplicaRequest{
+		Region: data.Region.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create volume replica, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("volume replica created: volume=%d ID=%d", volumeID, replica.Id))
+
+	err = waitUntil(ctx, func() (bool, error) {
+		return r.client.IsVolumeReplicaReady(volumeID, replica.Id)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check volume replica status, got error: %s", err))
+		return
+	}
+
+	replica, err = r.client.GetVolumeReplica(volumeID, replica.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get volume replica, got error: %s", err))
+		return
+	}
+	data.fromAPI(replica)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replica, err := r.client.GetVolumeReplica(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume replica, got error: %s", err))
+		return
+	}
+	data.fromAPI(replica)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VolumeReplicaResourceModel
+
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVolumeReplica(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume replica, got error: %s", err))
+		return
+	}
+}
+
+func (r *VolumeReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, replicaID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/replica_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), replicaID)...)
+}
+
+// parseVolumeChildID parses import identifiers of objects nested under a
+// volume, in the form `<volume id>/<id>`.
+func parseVolumeChildID(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid identifier %q", id)
+	}
+	volumeID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	childID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return volumeID, childID, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVolumeReplicaResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJuiceFSCloudPreCheck(t) },
+		ProtoV6ProviderFactories: testAccJuiceFSCloudProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig() + testAccVolumeReplicaResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juicefscloud_volume_replica.test", "volume", "juicefscloud_volume.test", "id"),
+					resource.TestCheckResourceAttrSet("juicefscloud_volume_replica.test", "id"),
+					resource.TestCheckResourceAttrSet("juicefscloud_volume_replica.test", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "juicefscloud_volume_replica.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccVolumeChildImportID("juicefscloud_volume_replica.test"),
+				ImportStateVerify: true,
+				// Replication progress changes between reads.
+				ImportStateVerifyIgnore: []string{"lag", "status"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccVolumeReplicaResourceConfig = `
+data "juicefscloud_cloud" "aws" {
+  name = "AWS"
+}
+
+data "juicefscloud_region" "oregon" {
+  cloud = data.juicefscloud_cloud.aws.id
+  name  = "us-west-2"
+}
+
+data "juicefscloud_region" "virginia" {
+  cloud = data.juicefscloud_cloud.aws.id
+  name  = "us-east-1"
+}
+
+resource "juicefscloud_volume" "test" {
+  name   = "test-tf-replica"
+  region = data.juicefscloud_region.oregon.id
+}
+
+resource "juicefscloud_volume_replica" "test" {
+  volume = juicefscloud_volume.test.id
+  region = data.juicefscloud_region.virginia.id
+}
+`
@@ -1,19 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
 	"terraform-provider-juicefscloud/internal/juicefs"
 	"time"
 )
@@ -21,6 +30,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VolumeResource{}
 var _ resource.ResourceWithImportState = &VolumeResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeResource{}
+var _ resource.ResourceWithValidateConfig = &VolumeResource{}
+var _ resource.ResourceWithUpgradeState = &VolumeResource{}
 
 func NewVolumeResource() resource.Resource {
 	return &VolumeResource{}
@@ -32,30 +44,47 @@ type VolumeResource struct {
 }
 
 type VolumeAccessRulesResourceModel struct {
-	IpRange    types.String `tfsdk:"ip_range"`
-	Token      types.String `tfsdk:"token"`
-	ReadOnly   types.Bool   `tfsdk:"read_only"`
-	AppendOnly types.Bool   `tfsdk:"append_only"`
+	Id          types.Int64  `tfsdk:"id"`
+	IpRange     types.String `tfsdk:"ip_range"`
+	Token       types.String `tfsdk:"token"`
+	ReadOnly    types.Bool   `tfsdk:"read_only"`
+	AppendOnly  types.Bool   `tfsdk:"append_only"`
+	Description types.String `tfsdk:"description"`
 }
 
 func (VolumeAccessRulesResourceModel) schema() schema.NestedAttributeObject {
 	return schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Export identifier of the access rule",
+				Computed:            true,
+			},
 			"ip_range": schema.StringAttribute{
 				MarkdownDescription: "IP range for access rules",
-				Computed:            true,
+				Required:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Token for access rules",
+				MarkdownDescription: "Token for access rules, null when `omit_tokens` is set",
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"read_only": schema.BoolAttribute{
-				MarkdownDescription: "Read-only access",
+				MarkdownDescription: "Read-only access, default to false",
+				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"append_only": schema.BoolAttribute{
-				MarkdownDescription: "Append-only access",
+				MarkdownDescription: "Append-only access, default to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the access rule",
+				Optional:            true,
 				Computed:            true,
+				Default:             stringdefault.StaticString(""),
 			},
 		},
 	}
@@ -63,31 +92,180 @@ func (VolumeAccessRulesResourceModel) schema() schema.NestedAttributeObject {
 
 func (VolumeAccessRulesResourceModel) attrType() map[string]attr.Type {
 	return map[string]attr.Type{
+		"id":          types.Int64Type,
 		"ip_range":    types.StringType,
 		"token":       types.StringType,
 		"read_only":   types.BoolType,
 		"append_only": types.BoolType,
+		"description": types.StringType,
+	}
+}
+
+func (m VolumeAccessRulesResourceModel) toAPI() juicefs.VolumeExportRequest {
+	return juicefs.VolumeExportRequest{
+		Desc:       m.Description.ValueString(),
+		IpRange:    m.IpRange.ValueString(),
+		ReadOnly:   m.ReadOnly.ValueBool(),
+		AppendOnly: m.AppendOnly.ValueBool(),
+	}
+}
+
+// accessRulesValue converts the exports of a volume into the access_rules
+// set, leaving tokens null when omitTokens is set.
+func accessRulesValue(ctx context.Context, exports []juicefs.VolumeExport, omitTokens bool) (types.Set, diag.Diagnostics) {
+	accessRules := make([]VolumeAccessRulesResourceModel, 0, len(exports))
+	for _, export := range exports {
+		token := types.StringValue(export.Token)
+		if omitTokens {
+			token = types.StringNull()
+		}
+		accessRules = append(accessRules, VolumeAccessRulesResourceModel{
+			Id:          types.Int64Value(export.Id),
+			IpRange:     types.StringValue(export.IpRange),
+			Token:       token,
+			ReadOnly:    types.BoolValue(export.ReadOnly),
+			AppendOnly:  types.BoolValue(export.AppendOnly),
+			Description: types.StringValue(export.Desc),
+		})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{
+		AttrTypes: VolumeAccessRulesResourceModel{}.attrType(),
+	}, accessRules)
+}
+
+// reconcileAccessRules creates, updates and deletes exports of the volume
+// until they match the configured access rules, keyed by IP range. Extra
+// exports of an IP range already present on the server are deleted.
+func (r *VolumeResource) reconcileAccessRules(ctx context.Context, volumeID int64, accessRules types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []VolumeAccessRulesResourceModel
+	diags.Append(accessRules.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	exports, err := r.client.GetVolumeExports(volumeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read volume exports, got error: %s", err))
+		return diags
 	}
+	existing := make(map[string][]juicefs.VolumeExport, len(exports))
+	for _, export := range exports {
+		existing[export.IpRange] = append(existing[export.IpRange], export)
+	}
+
+	for _, rule := range desired {
+		apiReq := rule.toAPI()
+		candidates := existing[apiReq.IpRange]
+		if len(candidates) == 0 {
+			created, err := r.client.CreateVolumeExport(volumeID, apiReq)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to create volume export for %s, got error: %s", apiReq.IpRange, err))
+				return diags
+			}
+			tflog.Trace(ctx, fmt.Sprintf("volume export created: iprange=%s ID=%d", created.IpRange, created.Id))
+			continue
+		}
+		export := candidates[0]
+		existing[apiReq.IpRange] = candidates[1:]
+		if export.ReadOnly == apiReq.ReadOnly && export.AppendOnly == apiReq.AppendOnly && export.Desc == apiReq.Desc {
+			continue
+		}
+		apiReq.ApiOnly = export.ApiOnly
+		if _, err := r.client.UpdateVolumeExport(volumeID, export.Id, apiReq); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update volume export for %s, got error: %s", apiReq.IpRange, err))
+			return diags
+		}
+	}
+
+	for _, extra := range existing {
+		for _, export := range extra {
+			if err := r.client.DeleteVolumeExport(volumeID, export.Id); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to delete volume export for %s, got error: %s", export.IpRange, err))
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// readAccessRules reads the exports of the volume into the access_rules set.
+func (r *VolumeResource) readAccessRules(ctx context.Context, volumeID int64, omitTokens bool) (types.Set, diag.Diagnostics) {
+	exports, err := r.client.GetVolumeExports(volumeID)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read volume exports, got error: %s", err))
+		return types.SetNull(types.ObjectType{AttrTypes: VolumeAccessRulesResourceModel{}.attrType()}), diags
+	}
+	return accessRulesValue(ctx, exports, omitTokens)
 }
 
 // VolumeResourceModel describes the resource data model.
 type VolumeResourceModel struct {
-	Id          types.Int64  `tfsdk:"id"`
-	AccessRules types.List   `tfsdk:"access_rules"`
-	Owner       types.Int64  `tfsdk:"owner"`
-	Size        types.Int64  `tfsdk:"size"`
-	Inodes      types.Int64  `tfsdk:"inodes"`
-	Created     types.String `tfsdk:"created"`
-	Uuid        types.String `tfsdk:"uuid"`
-	Name        types.String `tfsdk:"name"`
-	Region      types.Int64  `tfsdk:"region"`
-	Bucket      types.String `tfsdk:"bucket"`
-	TrashTime   types.Int64  `tfsdk:"trash_time"`
-	BlockSize   types.Int64  `tfsdk:"block_size"`
-	Compress    types.String `tfsdk:"compress"`
-	Compatible  types.Bool   `tfsdk:"compatible"`
-	Extend      types.String `tfsdk:"extend"`
-	Storage     types.String `tfsdk:"storage"`
+	Id          types.Int64          `tfsdk:"id"`
+	AccessRules types.Set            `tfsdk:"access_rules"`
+	OmitTokens  types.Bool           `tfsdk:"omit_tokens"`
+	Owner       types.Int64          `tfsdk:"owner"`
+	Size        types.Int64          `tfsdk:"size"`
+	Inodes      types.Int64          `tfsdk:"inodes"`
+	Created     types.String         `tfsdk:"created"`
+	Uuid        types.String         `tfsdk:"uuid"`
+	Name        types.String         `tfsdk:"name"`
+	Region      types.Int64          `tfsdk:"region"`
+	Bucket      types.String         `tfsdk:"bucket"`
+	TrashTime   types.Int64          `tfsdk:"trash_time"`
+	BlockSize   types.Int64          `tfsdk:"block_size"`
+	Compress    types.String         `tfsdk:"compress"`
+	Compatible  types.Bool           `tfsdk:"compatible"`
+	Extend      jsontypes.Normalized `tfsdk:"extend"`
+	Storage     types.String         `tfsdk:"storage"`
+}
+
+// toUpdateRequest returns the settings of the plan m that differ from the
+// prior state, and whether there are any.
+func (m VolumeResourceModel) toUpdateRequest(state VolumeResourceModel) (juicefs.UpdateVolumeRequest, bool) {
+	var req juicefs.UpdateVolumeRequest
+	changed := false
+	if !m.Name.Equal(state.Name) {
+		name := m.Name.ValueString()
+		req.Name = &name
+		changed = true
+	}
+	if !m.TrashTime.IsUnknown() && !m.TrashTime.IsNull() && !m.TrashTime.Equal(state.TrashTime) {
+		trashTime := m.TrashTime.ValueInt64()
+		req.TrashTime = &trashTime
+		changed = true
+	}
+	if !m.Compatible.IsUnknown() && !m.Compatible.IsNull() && !m.Compatible.Equal(state.Compatible) {
+		compatible := m.Compatible.ValueBool()
+		req.Compatible = &compatible
+		changed = true
+	}
+	if !m.Storage.IsUnknown() && !m.Storage.IsNull() && !m.Storage.Equal(state.Storage) {
+		storage := m.Storage.ValueString()
+		req.Storage = &storage
+		changed = true
+	}
+	return req, changed
+}
+
+// extendRequiresReplace replaces the volume when the configured extended
+// attributes differ from the state. Semantic equality is only applied to
+// apply results, so documents that only differ in formatting are compared
+// here to avoid planning a replacement.
+func extendRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+	if req.PlanValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+	equal, diags := jsontypes.NewNormalizedValue(req.PlanValue.ValueString()).StringSemanticEquals(ctx, jsontypes.NewNormalizedValue(req.StateValue.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !equal
 }
 
 func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,6 +276,9 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Volume resource",
+		// Version 1 turned access_rules from a computed list into a set of
+		// managed exports.
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -109,16 +290,23 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"access_rules": schema.ListNestedAttribute{
-				MarkdownDescription: "Specify access rules for the volume.",
+			"access_rules": schema.SetNestedAttribute{
+				MarkdownDescription: "Specify access rules for the volume. When set, exports of the volume not listed here are deleted; when unset, they are only read. Each IP range can only appear in one rule.",
 				Required:            false,
-				Optional:            false,
+				Optional:            true,
 				Computed:            true,
 				NestedObject:        VolumeAccessRulesResourceModel{}.schema(),
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"omit_tokens": schema.BoolAttribute{
+				MarkdownDescription: "Do not store access rule tokens in state, for when they are fetched another way, default to false",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"owner": schema.Int64Attribute{
 				MarkdownDescription: "Owner of the volume",
 				Required:            false,
@@ -130,6 +318,7 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"size": schema.Int64Attribute{
 				MarkdownDescription: "Size of the volume",
+				DeprecationMessage:  "Use the juicefscloud_volume_usage data source to read current usage instead.",
 				Required:            false,
 				Optional:            false,
 				Computed:            true,
@@ -139,6 +328,7 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"inodes": schema.Int64Attribute{
 				MarkdownDescription: "Number of inodes",
+				DeprecationMessage:  "Use the juicefscloud_volume_usage data source to read current usage instead.",
 				Required:            false,
 				Optional:            false,
 				Computed:            true,
@@ -165,7 +355,7 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the volume",
+				MarkdownDescription: "Name of the volume, renamed in place when changed",
 				Required:            true,
 				Optional:            false,
 				Computed:            false,
@@ -189,7 +379,7 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"trash_time": schema.Int64Attribute{
-				MarkdownDescription: "Trash time of the volume",
+				MarkdownDescription: "Trash time of the volume, can be updated in place",
 				Required:            false,
 				Optional:            true,
 				Computed:            true,
@@ -207,7 +397,7 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"compress": schema.StringAttribute{
-				MarkdownDescription: "Compression type for the volume",
+				MarkdownDescription: "Compression type for the volume, changing it replaces the volume",
 				Required:            false,
 				Optional:            true,
 				Computed:            true,
@@ -216,36 +406,119 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"compatible": schema.BoolAttribute{
-				MarkdownDescription: "Compatibility mode for the volume",
+				MarkdownDescription: "Compatibility mode for the volume, can be updated in place",
 				Required:            false,
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplaceIfConfigured(),
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"extend": schema.StringAttribute{
-				MarkdownDescription: "Extended attributes for the volume",
+				MarkdownDescription: "Extended attributes for the volume, as a JSON document. Formatting differences are ignored, other changes replace the volume.",
+				CustomType:          jsontypes.NormalizedType{},
 				Required:            false,
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.RequiresReplaceIf(
+						extendRequiresReplace,
+						"Changing the extended attributes, other than their formatting, replaces the volume.",
+						"Changing the extended attributes, other than their formatting, replaces the volume.",
+					),
 				},
 			},
 			"storage": schema.StringAttribute{
-				MarkdownDescription: "Storage type for the volume",
+				MarkdownDescription: "Storage type for the volume, can be updated in place",
 				Required:            false,
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 		},
 	}
 }
 
+// duplicateIPRanges returns the IP ranges configured in more than one access
+// rule. Exports are matched by IP range, so each range can only appear once.
+func duplicateIPRanges(rules []VolumeAccessRulesResourceModel) []string {
+	var duplicates []string
+	seen := make(map[string]int, len(rules))
+	for _, rule := range rules {
+		if rule.IpRange.IsUnknown() || rule.IpRange.IsNull() {
+			continue
+		}
+		ipRange := rule.IpRange.ValueString()
+		seen[ipRange]++
+		if seen[ipRange] == 2 {
+			duplicates = append(duplicates, ipRange)
+		}
+	}
+	return duplicates
+}
+
+func (r *VolumeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var accessRules types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_rules"), &accessRules)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if accessRules.IsUnknown() || accessRules.IsNull() {
+		return
+	}
+	var rules []VolumeAccessRulesResourceModel
+	resp.Diagnostics.Append(accessRules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, ipRange := range duplicateIPRanges(rules) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_rules"),
+			"Duplicate Access Rule",
+			fmt.Sprintf("IP range %q is used by more than one access rule, merge them into one.", ipRange),
+		)
+	}
+}
+
+// UpgradeState migrates states written before access_rules became a set of
+// managed exports. Lists and sets are both arrays in the JSON state, so only
+// the attributes added to the rules need to be filled in.
+func (r *VolumeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeVolumeStateV0},
+	}
+}
+
+func upgradeVolumeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to parse volume state, got error: %s", err))
+		return
+	}
+	if rules, ok := state["access_rules"].([]interface{}); ok {
+		for _, rule := range rules {
+			if attrs, ok := rule.(map[string]interface{}); ok {
+				if _, ok := attrs["description"]; !ok {
+					attrs["description"] = ""
+				}
+			}
+		}
+	}
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode volume state, got error: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
 func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -266,6 +539,43 @@ func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *VolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state VolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tokens are added to or dropped from access_rules when omit_tokens
+	// changes, so the state value cannot be reused.
+	if plan.OmitTokens.IsUnknown() || plan.OmitTokens.Equal(state.OmitTokens) || plan.AccessRules.IsUnknown() {
+		return
+	}
+	var accessRules []VolumeAccessRulesResourceModel
+	resp.Diagnostics.Append(plan.AccessRules.ElementsAs(ctx, &accessRules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range accessRules {
+		accessRules[i].Token = types.StringUnknown()
+	}
+	rules, diags := types.SetValueFrom(ctx, types.ObjectType{
+		AttrTypes: VolumeAccessRulesResourceModel{}.attrType(),
+	}, accessRules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AccessRules = rules
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VolumeResourceModel
 
@@ -300,7 +610,7 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		compatible := data.Compatible.ValueBool()
 		apiReq.Compatible = &compatible
 	}
-	if !data.Extend.IsNull() {
+	if !data.Extend.IsNull() && !data.Extend.IsUnknown() {
 		extend := data.Extend.ValueString()
 		apiReq.Extend = &extend
 	}
@@ -317,16 +627,19 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Trace(ctx, fmt.Sprintf("volume created: name=%s ID=%d", volume.Name, volume.Id))
 
-	for {
-		ready, err := r.client.IsVolumeReady(volume.Id)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check volume status, got error: %s", err))
+	err = waitUntil(ctx, func() (bool, error) {
+		return r.client.IsVolumeReady(volume.Id)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check volume status, got error: %s", err))
+		return
+	}
+
+	if !data.AccessRules.IsUnknown() && !data.AccessRules.IsNull() {
+		resp.Diagnostics.Append(r.reconcileAccessRules(ctx, volume.Id, data.AccessRules)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		if ready {
-			break
-		}
-		time.Sleep(1 * time.Second)
 	}
 
 	volume, err = r.client.GetVolume(volume.Id)
@@ -335,19 +648,8 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	data.Id = types.Int64Value(volume.Id)
-	accessRules := make([]VolumeAccessRulesResourceModel, 0)
-	for _, accessRule := range volume.AccessRules {
-		accessRules = append(accessRules, VolumeAccessRulesResourceModel{
-			IpRange:    types.StringValue(accessRule.IpRange),
-			Token:      types.StringValue(accessRule.Token),
-			ReadOnly:   types.BoolValue(accessRule.ReadOnly),
-			AppendOnly: types.BoolValue(accessRule.AppendOnly),
-		})
-	}
-	rules, diag := types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: VolumeAccessRulesResourceModel{}.attrType(),
-	}, accessRules)
-	resp.Diagnostics.Append(diag...)
+	rules, diags := r.readAccessRules(ctx, volume.Id, data.OmitTokens.ValueBool())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -373,7 +675,9 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Compress = types.StringValue(volume.Compress)
 	data.Compatible = types.BoolValue(volume.Compatible)
 	if volume.Extend != nil {
-		data.Extend = types.StringValue(*volume.Extend)
+		data.Extend = jsontypes.NewNormalizedValue(*volume.Extend)
+	} else {
+		data.Extend = jsontypes.NewNormalizedNull()
 	}
 	if volume.Storage != nil {
 		data.Storage = types.StringValue(*volume.Storage)
@@ -393,86 +697,105 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	volumes, err := r.client.GetVolumes()
+	var volume *juicefs.Volume
+	var err error
+	if data.Id.IsNull() {
+		// Volumes imported by name have no identifier yet.
+		volume, err = r.findVolumeByName(data.Name.ValueString())
+	} else {
+		volume, err = r.client.GetVolume(data.Id.ValueInt64())
+	}
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volumes, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
 		return
 	}
 
-	found := false
-	for _, volume := range volumes {
-		if volume.Name == data.Name.ValueString() {
-			found = true
-			tflog.Trace(ctx, fmt.Sprintf("found matching volume name %s", volume.Name))
-			data.Id = types.Int64Value(volume.Id)
-			accessRules := make([]VolumeAccessRulesDataSourceModel, 0)
-			for _, accessRule := range volume.AccessRules {
-				accessRules = append(accessRules, VolumeAccessRulesDataSourceModel{
-					IpRange:    types.StringValue(accessRule.IpRange),
-					Token:      types.StringValue(accessRule.Token),
-					ReadOnly:   types.BoolValue(accessRule.ReadOnly),
-					AppendOnly: types.BoolValue(accessRule.AppendOnly),
-				})
-			}
-			rules, diag := types.ListValueFrom(ctx, types.ObjectType{
-				AttrTypes: VolumeAccessRulesResourceModel{}.attrType(),
-			}, accessRules)
-			resp.Diagnostics.Append(diag...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			data.AccessRules = rules
-			data.Owner = types.Int64Value(volume.Owner)
-			if volume.Size != nil {
-				data.Size = types.Int64Value(*volume.Size)
-			}
-			if volume.Inodes != nil {
-				data.Inodes = types.Int64Value(*volume.Inodes)
-			}
-			data.Created = types.StringValue(volume.Created.Format(time.RFC3339))
-			data.Uuid = types.StringValue(volume.Uuid)
-			data.Name = types.StringValue(volume.Name)
-			data.Region = types.Int64Value(volume.Region)
-			data.Bucket = types.StringValue(volume.Bucket)
-			data.TrashTime = types.Int64Value(volume.TrashTime)
-			data.BlockSize = types.Int64Value(volume.BlockSize)
-			data.Compress = types.StringValue(volume.Compress)
-			data.Compatible = types.BoolValue(volume.Compatible)
-			if volume.Extend != nil {
-				data.Extend = types.StringValue(*volume.Extend)
-			}
-			if volume.Storage != nil {
-				data.Storage = types.StringValue(*volume.Storage)
-			}
-		}
+	data.Id = types.Int64Value(volume.Id)
+	if data.OmitTokens.IsNull() {
+		data.OmitTokens = types.BoolValue(false)
 	}
-
-	if !found {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find volume %s", data.Name.ValueString()))
+	rules, diags := r.readAccessRules(ctx, volume.Id, data.OmitTokens.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.AccessRules = rules
+	data.Owner = types.Int64Value(volume.Owner)
+	if volume.Size != nil {
+		data.Size = types.Int64Value(*volume.Size)
+	}
+	if volume.Inodes != nil {
+		data.Inodes = types.Int64Value(*volume.Inodes)
+	}
+	data.Created = types.StringValue(volume.Created.Format(time.RFC3339))
+	data.Uuid = types.StringValue(volume.Uuid)
+	data.Name = types.StringValue(volume.Name)
+	data.Region = types.Int64Value(volume.Region)
+	data.Bucket = types.StringValue(volume.Bucket)
+	data.TrashTime = types.Int64Value(volume.TrashTime)
+	data.BlockSize = types.Int64Value(volume.BlockSize)
+	data.Compress = types.StringValue(volume.Compress)
+	data.Compatible = types.BoolValue(volume.Compatible)
+	if volume.Extend != nil {
+		data.Extend = jsontypes.NewNormalizedValue(*volume.Extend)
+	} else {
+		data.Extend = jsontypes.NewNormalizedNull()
+	}
+	if volume.Storage != nil {
+		data.Storage = types.StringValue(*volume.Storage)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VolumeResourceModel
+	var data, state VolumeResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	if apiReq, changed := data.toUpdateRequest(state); changed {
+		volume, err := r.client.UpdateVolume(data.Id.ValueInt64(), apiReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update volume %s, got error: %s", state.Name.ValueString(), err))
+			return
+		}
+		tflog.Trace(ctx, fmt.Sprintf("volume updated: ID=%d name=%s", volume.Id, volume.Name))
+		data.Name = types.StringValue(volume.Name)
+		data.TrashTime = types.Int64Value(volume.TrashTime)
+		data.Compatible = types.BoolValue(volume.Compatible)
+		if volume.Storage != nil {
+			data.Storage = types.StringValue(*volume.Storage)
+		}
+	}
+
+	var configuredRules types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_rules"), &configuredRules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configuredRules.IsNull() {
+		resp.Diagnostics.Append(r.reconcileAccessRules(ctx, data.Id.ValueInt64(), data.AccessRules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	rules, diags := r.readAccessRules(ctx, data.Id.ValueInt64(), data.OmitTokens.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AccessRules = rules
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -495,6 +818,26 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// findVolumeByName looks up a volume by name, for states that predate volumes
+// being tracked by identifier.
+func (r *VolumeResource) findVolumeByName(name string) (*juicefs.Volume, error) {
+	volumes, err := r.client.GetVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("volume %s %w", name, juicefs.ErrNotFound)
+}
+
+// ImportState accepts either the numeric identifier or the name of the volume.
 func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"terraform-provider-juicefscloud/internal/juicefs"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -51,3 +59,105 @@ resource "scaffolding_example" "test" {
 }
 `, configurableAttribute)
 }
+
+func TestExtendRequiresReplace(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      types.String
+		plan        types.String
+		state       types.String
+		wantReplace bool
+	}{
+		{"reformatted", types.StringValue(`{"b": 2, "a": [1, 2]}`), types.StringValue(`{"b": 2, "a": [1, 2]}`), types.StringValue(`{"a":[1,2],"b":2}`), false},
+		{"changed", types.StringValue(`{"a":[1,2],"b":3}`), types.StringValue(`{"a":[1,2],"b":3}`), types.StringValue(`{"a":[1,2],"b":2}`), true},
+		{"unconfigured", types.StringNull(), types.StringUnknown(), types.StringValue(`{"a":1}`), false},
+		{"unknown", types.StringUnknown(), types.StringUnknown(), types.StringValue(`{"a":1}`), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{ConfigValue: tt.config, PlanValue: tt.plan, StateValue: tt.state}
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+			extendRequiresReplace(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.RequiresReplace != tt.wantReplace {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, tt.wantReplace)
+			}
+		})
+	}
+}
+
+func TestDuplicateIPRanges(t *testing.T) {
+	rules := []VolumeAccessRulesResourceModel{
+		{IpRange: types.StringValue("10.0.0.0/8"), ReadOnly: types.BoolValue(true)},
+		{IpRange: types.StringValue("10.0.0.0/8"), ReadOnly: types.BoolValue(false)},
+		{IpRange: types.StringValue("10.0.0.0/8")},
+		{IpRange: types.StringValue("192.168.0.0/16")},
+		{IpRange: types.StringUnknown()},
+		{IpRange: types.StringUnknown()},
+	}
+	got := duplicateIPRanges(rules)
+	if len(got) != 1 || got[0] != "10.0.0.0/8" {
+		t.Errorf("duplicateIPRanges() = %v, want [10.0.0.0/8]", got)
+	}
+}
+
+func TestUpgradeVolumeStateV0(t *testing.T) {
+	req := fwresource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":12345678901,"name":"myjfs","access_rules":[{"ip_range":"0.0.0.0/0","token":"t","read_only":false,"append_only":false}]}`),
+		},
+	}
+	resp := &fwresource.UpgradeStateResponse{}
+	upgradeVolumeStateV0(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	want := `{"access_rules":[{"append_only":false,"description":"","ip_range":"0.0.0.0/0","read_only":false,"token":"t"}],"id":12345678901,"name":"myjfs"}`
+	if got := string(resp.DynamicValue.JSON); got != want {
+		t.Errorf("upgraded state = %s, want %s", got, want)
+	}
+}
+
+func TestVolumeResourceModelToUpdateRequest(t *testing.T) {
+	state := VolumeResourceModel{
+		Name:       types.StringValue("data"),
+		TrashTime:  types.Int64Value(1),
+		Compatible: types.BoolValue(false),
+		Storage:    types.StringValue("s3"),
+	}
+	name, trashTime, compatible, storage := "logs", int64(7), true, "gs"
+
+	tests := []struct {
+		name        string
+		plan        func(m *VolumeResourceModel)
+		want        juicefs.UpdateVolumeRequest
+		wantChanged bool
+	}{
+		{"unchanged", func(m *VolumeResourceModel) {}, juicefs.UpdateVolumeRequest{}, false},
+		{"name", func(m *VolumeResourceModel) { m.Name = types.StringValue(name) }, juicefs.UpdateVolumeRequest{Name: &name}, true},
+		{"trash_time", func(m *VolumeResourceModel) { m.TrashTime = types.Int64Value(trashTime) }, juicefs.UpdateVolumeRequest{TrashTime: &trashTime}, true},
+		{"trash_time unknown", func(m *VolumeResourceModel) { m.TrashTime = types.Int64Unknown() }, juicefs.UpdateVolumeRequest{}, false},
+		{"trash_time null", func(m *VolumeResourceModel) { m.TrashTime = types.Int64Null() }, juicefs.UpdateVolumeRequest{}, false},
+		{"compatible", func(m *VolumeResourceModel) { m.Compatible = types.BoolValue(compatible) }, juicefs.UpdateVolumeRequest{Compatible: &compatible}, true},
+		{"compatible unknown", func(m *VolumeResourceModel) { m.Compatible = types.BoolUnknown() }, juicefs.UpdateVolumeRequest{}, false},
+		{"compatible null", func(m *VolumeResourceModel) { m.Compatible = types.BoolNull() }, juicefs.UpdateVolumeRequest{}, false},
+		{"storage", func(m *VolumeResourceModel) { m.Storage = types.StringValue(storage) }, juicefs.UpdateVolumeRequest{Storage: &storage}, true},
+		{"storage unknown", func(m *VolumeResourceModel) { m.Storage = types.StringUnknown() }, juicefs.UpdateVolumeRequest{}, false},
+		{"storage null", func(m *VolumeResourceModel) { m.Storage = types.StringNull() }, juicefs.UpdateVolumeRequest{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			tt.plan(&plan)
+			got, changed := plan.toUpdateRequest(state)
+			if changed != tt.wantChanged {
+				t.Errorf("toUpdateRequest() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toUpdateRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
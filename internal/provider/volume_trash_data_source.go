@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &VolumeTrashDataSource{}
+
+func NewVolumeTrashDataSource() datasource.DataSource {
+	return &VolumeTrashDataSource{}
+}
+
+// VolumeTrashDataSource defines the data source implementation.
+type VolumeTrashDataSource struct {
+	client *juicefs.Client
+}
+
+// VolumeTrashDataSourceModel describes the data source data model.
+type VolumeTrashDataSourceModel struct {
+	Volume        types.Int64  `tfsdk:"volume"`
+	OlderThanDays types.Int64  `tfsdk:"older_than_days"`
+	ItemCount     types.Int64  `tfsdk:"item_count"`
+	Size          types.Int64  `tfsdk:"size"`
+	OldestDeleted types.String `tfsdk:"oldest_deleted"`
+	NewestDeleted types.String `tfsdk:"newest_deleted"`
+}
+
+func (d *VolumeTrashDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_trash"
+}
+
+func (d *VolumeTrashDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Summary of the trash of a volume",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"older_than_days": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				MarkdownDescription: "Only count entries deleted more than this many days ago",
+			},
+			"item_count": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Number of entries in the trash",
+			},
+			"size": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Total bytes of the entries in the trash",
+			},
+			"oldest_deleted": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Deletion time of the oldest entry, in RFC 3339 format",
+			},
+			"newest_deleted": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Deletion time of the newest entry, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (d *VolumeTrashDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumeTrashDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeTrashDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := d.client.GetVolumeTrash(data.Volume.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume trash, got error: %s", err))
+		return
+	}
+
+	var (
+		count          int64
+		size           int64
+		oldest, newest time.Time
+	)
+	cutoff := time.Now()
+	if !data.OlderThanDays.IsNull() {
+		cutoff = cutoff.AddDate(0, 0, -int(data.OlderThanDays.ValueInt64()))
+	}
+	for _, entry := range entries {
+		if entry.Deleted.After(cutoff) {
+			continue
+		}
+		count++
+		size += entry.Size
+		if oldest.IsZero() || entry.Deleted.Before(oldest) {
+			oldest = entry.Deleted
+		}
+		if newest.IsZero() || entry.Deleted.After(newest) {
+			newest = entry.Deleted
+		}
+	}
+
+	data.ItemCount = types.Int64Value(count)
+	data.Size = types.Int64Value(size)
+	data.OldestDeleted = types.StringNull()
+	data.NewestDeleted = types.StringNull()
+	if count > 0 {
+		data.OldestDeleted = types.StringValue(oldest.Format(time.RFC3339))
+		data.NewestDeleted = types.StringValue(newest.Format(time.RFC3339))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
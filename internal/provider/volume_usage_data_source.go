@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &VolumeUsageDataSource{}
+
+func NewVolumeUsageDataSource() datasource.DataSource {
+	return &VolumeUsageDataSource{}
+}
+
+// VolumeUsageDataSource defines the data source implementation.
+type VolumeUsageDataSource struct {
+	client *juicefs.Client
+}
+
+// VolumeUsageDataSourceModel describes the data source data model.
+type VolumeUsageDataSourceModel struct {
+	Volume    types.Int64  `tfsdk:"volume"`
+	Size      types.Int64  `tfsdk:"size"`
+	Inodes    types.Int64  `tfsdk:"inodes"`
+	TrashSize types.Int64  `tfsdk:"trash_size"`
+	Objects   types.Int64  `tfsdk:"objects"`
+	ReadAt    types.String `tfsdk:"read_at"`
+}
+
+func (d *VolumeUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_usage"
+}
+
+func (d *VolumeUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Current usage of a volume",
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.Int64Attribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				MarkdownDescription: "Volume identifier",
+			},
+			"size": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Used bytes of the volume",
+			},
+			"inodes": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Number of inodes in use",
+			},
+			"trash_size": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Bytes held in the volume trash",
+			},
+			"objects": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Number of objects in the bucket",
+			},
+			"read_at": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Time the usage was read, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (d *VolumeUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumeUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.client.GetVolumeUsage(data.Volume.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume usage, got error: %s", err))
+		return
+	}
+
+	data.Size = types.Int64Value(usage.Size)
+	data.Inodes = types.Int64Value(usage.Inodes)
+	data.TrashSize = types.Int64Value(usage.TrashSize)
+	data.Objects = types.Int64Value(usage.Objects)
+	data.ReadAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVolumeUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJuiceFSCloudPreCheck(t) },
+		ProtoV6ProviderFactories: testAccJuiceFSCloudProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + testAccVolumeUsageDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.juicefscloud_volume_usage.test", "size"),
+					resource.TestCheckResourceAttrSet("data.juicefscloud_volume_usage.test", "inodes"),
+					resource.TestCheckResourceAttrSet("data.juicefscloud_volume_usage.test", "read_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVolumeUsageDataSourceConfig = `
+data "juicefscloud_volume" "test" {
+  name = "test-tf-vol1"
+}
+
+data "juicefscloud_volume_usage" "test" {
+  volume = data.juicefscloud_volume.test.id
+}
+`
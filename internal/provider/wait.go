@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// waitUntil polls check every second until it reports done, returns an error
+// or ctx is cancelled.
+func waitUntil(ctx context.Context, check func() (bool, error)) error {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
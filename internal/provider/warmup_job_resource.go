@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-juicefscloud/internal/juicefs"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WarmupJobResource{}
+var _ resource.ResourceWithImportState = &WarmupJobResource{}
+
+// defaultWarmupTimeout bounds how long Create waits for a warmup job when no
+// create timeout is configured.
+const defaultWarmupTimeout = 30 * time.Minute
+
+func NewWarmupJobResource() resource.Resource {
+	return &WarmupJobResource{}
+}
+
+// WarmupJobResource defines the resource implementation.
+type WarmupJobResource struct {
+	client *juicefs.Client
+}
+
+// WarmupJobResourceModel describes the resource data model.
+type WarmupJobResourceModel struct {
+	Id          types.Int64    `tfsdk:"id"`
+	Volume      types.Int64    `tfsdk:"volume"`
+	Paths       types.List     `tfsdk:"paths"`
+	CacheGroup  types.String   `tfsdk:"cache_group"`
+	Concurrency types.Int64    `tfsdk:"concurrency"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	Status      types.String   `tfsdk:"status"`
+	Progress    types.Float64  `tfsdk:"progress"`
+	BytesWarmed types.Int64    `tfsdk:"bytes_warmed"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *WarmupJobResourceModel) fromAPI(ctx context.Context, job *juicefs.WarmupJob) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Id = types.Int64Value(job.Id)
+	m.Volume = types.Int64Value(job.Volume)
+	m.Paths, diags = types.ListValueFrom(ctx, types.StringType, job.Paths)
+	if job.CacheGroup != "" || !m.CacheGroup.IsNull() {
+		m.CacheGroup = types.StringValue(job.CacheGroup)
+	}
+	m.Concurrency = types.Int64Value(job.Concurrency)
+	m.Status = types.StringValue(job.Status)
+	m.Progress = types.Float64Value(job.Progress)
+	m.BytesWarmed = types.Int64Value(job.BytesWarmed)
+	return diags
+}
+
+func (r *WarmupJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warmup_job"
+}
+
+func (r *WarmupJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warms the cache with directories of a volume. Creation blocks until the job completes, " +
+			"so that resources depending on it only start once the cache is warm. Change `triggers` to run the job again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				MarkdownDescription: "Warmup job identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume identifier",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Directories or files of the volume to warm up",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"cache_group": schema.StringAttribute{
+				MarkdownDescription: "Name of the cache group to warm up, e.g. the `name` of a `juicefscloud_cache_group`",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: "Number of concurrent workers, default to the service default",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the warmup job again when changed",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the warmup job",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"progress": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of the data warmed, between 0 and 1",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"bytes_warmed": schema.Int64Attribute{
+				MarkdownDescription: "Bytes loaded into the cache",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *WarmupJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juicefs.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juicefs.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitForCompletion polls the warmup job until it succeeds and returns its
+// last known state, even when waiting fails.
+func (r *WarmupJobResource) waitForCompletion(ctx context.Context, volumeID int64, job *juicefs.WarmupJob) (*juicefs.WarmupJob, error) {
+	err := waitUntil(ctx, func() (bool, error) {
+		current, err := r.client.GetWarmupJob(volumeID, job.Id)
+		if err != nil {
+			return false, err
+		}
+		job = current
+		tflog.Debug(ctx, fmt.Sprintf("warmup job %d: status=%s progress=%.2f", job.Id, job.Status, job.Progress))
+		if job.Status == juicefs.WarmupJobStatusFailed {
+			return false, fmt.Errorf("warmup job %d failed: %s", job.Id, job.Error)
+		}
+		return job.Status == juicefs.WarmupJobStatusSucceeded, nil
+	})
+	return job, err
+}
+
+func (r *WarmupJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WarmupJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWarmupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq := juicefs.SubmitWarmupJobRequest{
+		CacheGroup:  data.CacheGroup.ValueString(),
+		Concurrency: data.Concurrency.ValueInt64(),
+	}
+	resp.Diagnostics.Append(data.Paths.ElementsAs(ctx, &apiReq.Paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.Volume.ValueInt64()
+	job, err := r.client.SubmitWarmupJob(volumeID, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to submit warmup job, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("warmup job submitted: volume=%d ID=%d", volumeID, job.Id))
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	job, err = r.waitForCompletion(waitCtx, volumeID, job)
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete warmup job %d, got error: %s", job.Id, err))
+	}
+
+	// Save data into Terraform state, so that an unfinished job is tainted
+	// rather than forgotten.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WarmupJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WarmupJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.GetWarmupJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if errors.Is(err, juicefs.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read warmup job, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WarmupJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WarmupJobResourceModel
+
+	// Only timeouts can change in place, so there is nothing to send to the
+	// API; refresh the computed attributes instead.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.GetWarmupJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read warmup job, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.fromAPI(ctx, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WarmupJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WarmupJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Warmed data stays in the cache; only a job that is still running is
+	// cancelled.
+	err := r.client.CancelWarmupJob(data.Volume.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel warmup job, got error: %s", err))
+		return
+	}
+}
+
+func (r *WarmupJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, jobID, err := parseVolumeChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: volume_id/warmup_job_id. Got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), jobID)...)
+}